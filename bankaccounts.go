@@ -1,5 +1,10 @@
 package zohobooks
 
+import (
+	"context"
+	"net/url"
+)
+
 // BankAccount struct will contain all the information of bank
 type BankAccount struct {
 	ID       string `json:"account_id"`
@@ -10,7 +15,21 @@ type BankAccount struct {
 }
 
 type BankAccountFindOptions struct {
+	// Deprecated: FilterBy and SortColumn hide the fields of ListOptions, use
+	// those instead. When set they take precedence over the ListOptions ones
 	FilterBy, SortColumn string
+	ListOptions
+}
+
+// Values encodes the options as query parameters
+func (o *BankAccountFindOptions) Values() url.Values {
+	if o == nil {
+		return url.Values{}
+	}
+	var v = o.ListOptions.Values()
+	setString(v, "filter_by", o.FilterBy)
+	setString(v, "sort_column", o.SortColumn)
+	return v
 }
 
 // New method will create an object and return a pointer to it
@@ -24,26 +43,14 @@ func (ba *BankAccount) Endpoint() string {
 	return "/bankaccounts"
 }
 
-func (ba *BankAccount) findAllEndpoint(opts *BankAccountFindOptions) string {
-	endpoint := ba.Endpoint()
-	if opts != nil {
-		var append string
-		if len(opts.FilterBy) > 0 {
-			append += "filter_by=" + opts.FilterBy + "&"
-		}
-		if len(opts.SortColumn) > 0 {
-			append += "sort_column=" + opts.SortColumn + "&"
-		}
-		if len(append) > 0 {
-			endpoint += "?" + append
-		}
-	}
-	return endpoint
-}
-
 // FindAll tries to find the contacts with given options
 func (ba *BankAccount) FindAll(opts *BankAccountFindOptions, client *Client) ([]BankAccount, error) {
-	resp, err := client.Get(ba.findAllEndpoint(opts))
+	return ba.FindAllWithContext(context.Background(), opts, client)
+}
+
+// FindAllWithContext tries to find the bank accounts with given options using the given context
+func (ba *BankAccount) FindAllWithContext(ctx context.Context, opts *BankAccountFindOptions, client *Client) ([]BankAccount, error) {
+	resp, err := client.GetWithContext(ctx, withQuery(ba.Endpoint(), opts))
 	respData, err := SendResp(resp, err, ba)
 
 	var results []BankAccount
@@ -55,3 +62,15 @@ func (ba *BankAccount) FindAll(opts *BankAccountFindOptions, client *Client) ([]
 	}
 	return results, err
 }
+
+// Iterator returns an iterator over all the pages of bank accounts with given options
+func (ba *BankAccount) Iterator(opts *BankAccountFindOptions, client *Client) *Iterator[BankAccount] {
+	return NewIterator(func(ctx context.Context, page, perPage int) ([]BankAccount, *PageContext, error) {
+		resp, err := client.GetWithContext(ctx, withPage(ba.Endpoint(), opts, page, perPage))
+		respData, err := SendResp(resp, err, ba)
+		if err != nil {
+			return nil, nil, err
+		}
+		return respData.BankAccounts, &respData.PageContext, nil
+	})
+}
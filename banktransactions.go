@@ -1,6 +1,7 @@
 package zohobooks
 
 import (
+	"context"
 	"encoding/json"
 )
 
@@ -48,8 +49,13 @@ func (bt *BankTransaction) Endpoint() string {
 
 // Create method will try to create a bank transaction on zohobooks
 func (bt *BankTransaction) Create(params *BankTransactionParams, client *Client) (*BankTransaction, error) {
+	return bt.CreateWithContext(context.Background(), params, client)
+}
+
+// CreateWithContext will try to create a bank transaction using the given context
+func (bt *BankTransaction) CreateWithContext(ctx context.Context, params *BankTransactionParams, client *Client) (*BankTransaction, error) {
 	var body, _ = json.Marshal(params)
-	resp, err := client.Post(bt.Endpoint(), string(body))
+	resp, err := client.PostWithContext(ctx, bt.Endpoint(), string(body))
 
 	respData, err := SendResp(resp, err, bt)
 	if err != nil {
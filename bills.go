@@ -0,0 +1,264 @@
+package zohobooks
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+)
+
+// Bill struct represents the information of a bill received from a vendor
+type Bill struct {
+	ID         string `json:"bill_id"`
+	BillNumber string `json:"bill_number"`
+	VendorID   string `json:"vendor_id"`
+	VendorName string `json:"vendor_name"`
+
+	// possible values ---> vat_registered,vat_not_registered,gcc_vat_not_registered,gcc_vat_registered,non_gcc,dz_vat_registered and dz_vat_not_registered.
+	TaxTreatment   string `json:"tax_treatment"`
+	GstNO          string `json:"gst_no"`        // 15 digit
+	GstTreatment   string `json:"gst_treatment"` // Allowed values are business_gst , business_none , overseas , consumer
+	SourceOfSupply string `json:"source_of_supply"`
+	DestOfSupply   string `json:"destination_of_supply"`
+
+	Status            string     `json:"status"` // draft, open, overdue, paid, void or partially_paid
+	Date              string     `json:"date"`
+	DueDate           string     `json:"due_date"`
+	PaymentTerms      int        `json:"payment_terms"`
+	PaymentTermsLabel string     `json:"payment_terms_label"`
+	CurrencyCode      string     `json:"currency_code"`
+	CurrencyID        string     `json:"currency_id"`
+	ExchangeRate      float64    `json:"exchange_rate"`
+	IsInclusiveTax    bool       `json:"is_inclusive_tax"`
+	RefNo             string     `json:"reference_number"`
+	LineItems         []LineItem `json:"line_items"`
+	Notes             string     `json:"notes"`
+	Terms             string     `json:"terms"`
+	BranchID          string     `json:"branch_id"`
+
+	SubTotal       float64   `json:"sub_total"`
+	TaxTotal       float64   `json:"tax_total"`
+	Total          float64   `json:"total"`
+	Taxes          []taxInfo `json:"taxes"`
+	PaymentMade    float64   `json:"payment_made"`
+	Balance        float64   `json:"balance"`
+	AttachmentName string    `json:"attachment_name"`
+
+	CreatedTime      string `json:"created_time"`
+	LastModifiedTime string `json:"last_modified_time"`
+
+	BillingAddress BillingAddress `json:"billing_address"`
+}
+
+// BillParams struct represents the information to create a bill
+type BillParams struct {
+	VendorID   string `json:"vendor_id"`
+	BillNumber string `json:"bill_number"`
+	RefNo      string `json:"reference_number,omitempty"`
+
+	// possible values ---> vat_registered,vat_not_registered,gcc_vat_not_registered,gcc_vat_registered,non_gcc,dz_vat_registered and dz_vat_not_registered.
+	TaxTreatment   string `json:"tax_treatment,omitempty"`
+	GstNO          string `json:"gst_no,omitempty"`        // 15 digit
+	GstTreatment   string `json:"gst_treatment,omitempty"` // Allowed values are business_gst , business_none , overseas , consumer
+	SourceOfSupply string `json:"source_of_supply,omitempty"`
+	DestOfSupply   string `json:"destination_of_supply,omitempty"`
+
+	Date              string     `json:"date,omitempty"`
+	DueDate           string     `json:"due_date,omitempty"`
+	PaymentTerms      int        `json:"payment_terms,omitempty"`
+	PaymentTermsLabel string     `json:"payment_terms_label,omitempty"`
+	ExchangeRate      float64    `json:"exchange_rate,omitempty"`
+	IsInclusiveTax    bool       `json:"is_inclusive_tax"`
+	LineItems         []LineItem `json:"line_items"`
+	Notes             string     `json:"notes,omitempty"`
+	Terms             string     `json:"terms,omitempty"`
+	BranchID          string     `json:"branch_id,omitempty"`
+
+	PurchaseOrderIDs []string `json:"purchaseorder_ids,omitempty"`
+}
+
+// BillFindOptions struct contains the filters used to list bills
+type BillFindOptions struct {
+	VendorID    string
+	BillNumber  string
+	ReferenceNo string
+	Status      string
+	ListOptions
+}
+
+// Values encodes the options as query parameters
+func (o *BillFindOptions) Values() url.Values {
+	if o == nil {
+		return url.Values{}
+	}
+	var v = o.ListOptions.Values()
+	setString(v, "vendor_id", o.VendorID)
+	setString(v, "bill_number", o.BillNumber)
+	setString(v, "reference_number", o.ReferenceNo)
+	setString(v, "status", o.Status)
+	return v
+}
+
+// New method will create a bill object and return a pointer to it
+func (b *Bill) New() Resource {
+	var obj = &Bill{}
+	return obj
+}
+
+// Endpoint method returns the endpoint of the resource
+func (b *Bill) Endpoint() string {
+	return "/bills"
+}
+
+// Create method will try to create a bill on zohobooks
+func (b *Bill) Create(params *BillParams, client *Client) (*Bill, error) {
+	return b.CreateWithContext(context.Background(), params, client)
+}
+
+// CreateWithContext will try to create a bill using the given context
+func (b *Bill) CreateWithContext(ctx context.Context, params *BillParams, client *Client) (*Bill, error) {
+	var body, _ = json.Marshal(params)
+	resp, err := client.PostWithContext(ctx, b.Endpoint(), string(body))
+
+	respData, err := SendResp(resp, err, b)
+	if err != nil {
+		return b, err
+	}
+	return &respData.Bill, err
+}
+
+// FindOne tries to find the bill with given id
+func (b *Bill) FindOne(id string, client *Client) (*Bill, error) {
+	return b.FindOneWithContext(context.Background(), id, client)
+}
+
+// FindOneWithContext tries to find the bill with given id using the given context
+func (b *Bill) FindOneWithContext(ctx context.Context, id string, client *Client) (*Bill, error) {
+	resp, err := client.GetWithContext(ctx, b.Endpoint()+"/"+id)
+	respData, err := SendResp(resp, err, b)
+	if err != nil {
+		return b, err
+	}
+	return &respData.Bill, err
+}
+
+// FindAll tries to find the bills with given options
+func (b *Bill) FindAll(opts *BillFindOptions, client *Client) ([]Bill, error) {
+	return b.FindAllWithContext(context.Background(), opts, client)
+}
+
+// FindAllWithContext tries to find the bills with given options using the given context
+func (b *Bill) FindAllWithContext(ctx context.Context, opts *BillFindOptions, client *Client) ([]Bill, error) {
+	resp, err := client.GetWithContext(ctx, withQuery(b.Endpoint(), opts))
+	respData, err := SendResp(resp, err, b)
+
+	var results []Bill
+	if err != nil {
+		return results, err
+	}
+	for _, bill := range respData.Bills {
+		results = append(results, bill)
+	}
+	return results, err
+}
+
+// Iterator returns an iterator over all the pages of bills with given options
+func (b *Bill) Iterator(opts *BillFindOptions, client *Client) *Iterator[Bill] {
+	return NewIterator(func(ctx context.Context, page, perPage int) ([]Bill, *PageContext, error) {
+		resp, err := client.GetWithContext(ctx, withPage(b.Endpoint(), opts, page, perPage))
+		respData, err := SendResp(resp, err, b)
+		if err != nil {
+			return nil, nil, err
+		}
+		return respData.Bills, &respData.PageContext, nil
+	})
+}
+
+// Update method will try to update a bill on zohobooks
+func (b *Bill) Update(id string, params *BillParams, client *Client) (*Bill, error) {
+	return b.UpdateWithContext(context.Background(), id, params, client)
+}
+
+// UpdateWithContext will try to update a bill using the given context
+func (b *Bill) UpdateWithContext(ctx context.Context, id string, params *BillParams, client *Client) (*Bill, error) {
+	var body, _ = json.Marshal(params)
+	resp, err := client.PutWithContext(ctx, b.Endpoint()+"/"+id, string(body))
+
+	respData, err := SendResp(resp, err, b)
+	if err != nil {
+		return b, err
+	}
+	return &respData.Bill, err
+}
+
+// Delete tries to delete the bill with given id
+func (b *Bill) Delete(id string, client *Client) error {
+	return b.DeleteWithContext(context.Background(), id, client)
+}
+
+// DeleteWithContext tries to delete the bill with given id using the given context
+func (b *Bill) DeleteWithContext(ctx context.Context, id string, client *Client) error {
+	resp, err := client.DeleteWithContext(ctx, b.Endpoint()+"/"+id)
+	_, err = SendResp(resp, err, b)
+	return err
+}
+
+// MarkOpen marks the draft bill with given id as open
+func (b *Bill) MarkOpen(id string, client *Client) error {
+	return b.MarkOpenWithContext(context.Background(), id, client)
+}
+
+// MarkOpenWithContext marks the draft bill with given id as open using the given context
+func (b *Bill) MarkOpenWithContext(ctx context.Context, id string, client *Client) error {
+	resp, err := client.postAction(ctx, b.Endpoint()+"/"+id+"/status/open")
+	_, err = SendResp(resp, err, b)
+	return err
+}
+
+// Void marks the bill with given id as void
+func (b *Bill) Void(id string, client *Client) error {
+	return b.VoidWithContext(context.Background(), id, client)
+}
+
+// VoidWithContext marks the bill with given id as void using the given context
+func (b *Bill) VoidWithContext(ctx context.Context, id string, client *Client) error {
+	resp, err := client.postAction(ctx, b.Endpoint()+"/"+id+"/status/void")
+	_, err = SendResp(resp, err, b)
+	return err
+}
+
+// UploadAttachment attaches the file at filepath to the bill with given id
+func (b *Bill) UploadAttachment(id, filepath string, client *Client) error {
+	return b.UploadAttachmentWithContext(context.Background(), id, filepath, client)
+}
+
+// UploadAttachmentWithContext attaches the file at filepath to the bill with
+// given id using the given context
+func (b *Bill) UploadAttachmentWithContext(ctx context.Context, id, filepath string, client *Client) error {
+	resp, err := client.upload(ctx, b.Endpoint()+"/"+id+"/attachment", "attachment", filepath)
+	_, err = SendResp(resp, err, b)
+	return err
+}
+
+// DownloadAttachment will download the attachment of the bill to the given filepath
+func (b *Bill) DownloadAttachment(id, filepath string, client *Client) error {
+	return b.DownloadAttachmentWithContext(context.Background(), id, filepath, client)
+}
+
+// DownloadAttachmentWithContext will download the attachment of the bill to
+// the given filepath using the given context
+func (b *Bill) DownloadAttachmentWithContext(ctx context.Context, id, filepath string, client *Client) error {
+	return client.download(ctx, b.Endpoint()+"/"+id+"/attachment", filepath)
+}
+
+// DeleteAttachment removes the attachment of the bill with given id
+func (b *Bill) DeleteAttachment(id string, client *Client) error {
+	return b.DeleteAttachmentWithContext(context.Background(), id, client)
+}
+
+// DeleteAttachmentWithContext removes the attachment of the bill with given id
+// using the given context
+func (b *Bill) DeleteAttachmentWithContext(ctx context.Context, id string, client *Client) error {
+	resp, err := client.DeleteWithContext(ctx, b.Endpoint()+"/"+id+"/attachment")
+	_, err = SendResp(resp, err, b)
+	return err
+}
@@ -0,0 +1,257 @@
+package zohobooks
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+)
+
+// ChartOfAccount struct represents an account of the chart of accounts
+type ChartOfAccount struct {
+	ID               string  `json:"account_id"`
+	Name             string  `json:"account_name"`
+	Code             string  `json:"account_code"`
+	Type             string  `json:"account_type"` // e.g. other_asset, income, expense, bank
+	Description      string  `json:"description"`
+	IsActive         bool    `json:"is_active"`
+	IsUserCreated    bool    `json:"is_user_created"`
+	IsSystemAccount  bool    `json:"is_system_account"`
+	ParentAccountID  string  `json:"parent_account_id"`
+	CurrencyID       string  `json:"currency_id"`
+	CurrencyCode     string  `json:"currency_code"`
+	CurrentBalance   float64 `json:"current_balance"`
+	HasAttachment    bool    `json:"has_attachment"`
+	CreatedTime      string  `json:"created_time"`
+	LastModifiedTime string  `json:"last_modified_time"`
+}
+
+// ChartOfAccountParams struct represents the information to create an account
+type ChartOfAccountParams struct {
+	Name            string `json:"account_name"`
+	Code            string `json:"account_code,omitempty"`
+	Type            string `json:"account_type"`
+	Description     string `json:"description,omitempty"`
+	ParentAccountID string `json:"parent_account_id,omitempty"`
+	CurrencyID      string `json:"currency_id,omitempty"`
+}
+
+// ChartOfAccountFindOptions struct contains the filters used to list accounts,
+// FilterBy of ListOptions accepts values such as AccountType.Active
+type ChartOfAccountFindOptions struct {
+	ShowBalance bool
+	ListOptions
+}
+
+// Values encodes the options as query parameters
+func (o *ChartOfAccountFindOptions) Values() url.Values {
+	if o == nil {
+		return url.Values{}
+	}
+	var v = o.ListOptions.Values()
+	if o.ShowBalance {
+		v.Set("showbalance", "true")
+	}
+	return v
+}
+
+// AccountTransaction struct represents a transaction recorded in an account
+type AccountTransaction struct {
+	ID                string  `json:"categorized_transaction_id"`
+	TransactionID     string  `json:"transaction_id"`
+	TransactionType   string  `json:"transaction_type"`
+	TransactionDate   string  `json:"transaction_date"`
+	AccountID         string  `json:"account_id"`
+	AccountName       string  `json:"account_name"`
+	EntityName        string  `json:"customer_name"`
+	OffsetAccountName string  `json:"offset_account_name"`
+	DebitOrCredit     string  `json:"debit_or_credit"`
+	DebitAmount       float64 `json:"debit_amount"`
+	CreditAmount      float64 `json:"credit_amount"`
+	RefNo             string  `json:"reference_number"`
+	ReconcileStatus   string  `json:"reconcile_status"`
+}
+
+// AccountTransactionFindOptions struct contains the filters used to list the
+// transactions of an account
+type AccountTransactionFindOptions struct {
+	AccountID       string
+	Amount          float64
+	TransactionType string
+	ListOptions
+}
+
+// Values encodes the options as query parameters
+func (o *AccountTransactionFindOptions) Values() url.Values {
+	if o == nil {
+		return url.Values{}
+	}
+	var v = o.ListOptions.Values()
+	setString(v, "account_id", o.AccountID)
+	setString(v, "transaction_type", o.TransactionType)
+	if o.Amount > 0 {
+		v.Set("amount", formatFloat(o.Amount))
+	}
+	return v
+}
+
+// New method will create an account object and return a pointer to it
+func (ca *ChartOfAccount) New() Resource {
+	var obj = &ChartOfAccount{}
+	return obj
+}
+
+// Endpoint method returns the endpoint of the resource
+func (ca *ChartOfAccount) Endpoint() string {
+	return "/chartofaccounts"
+}
+
+// Create method will try to create an account on zohobooks
+func (ca *ChartOfAccount) Create(params *ChartOfAccountParams, client *Client) (*ChartOfAccount, error) {
+	return ca.CreateWithContext(context.Background(), params, client)
+}
+
+// CreateWithContext will try to create an account using the given context
+func (ca *ChartOfAccount) CreateWithContext(ctx context.Context, params *ChartOfAccountParams, client *Client) (*ChartOfAccount, error) {
+	var body, _ = json.Marshal(params)
+	resp, err := client.PostWithContext(ctx, ca.Endpoint(), string(body))
+
+	respData, err := SendResp(resp, err, ca)
+	if err != nil {
+		return ca, err
+	}
+	return &respData.ChartOfAccount, err
+}
+
+// FindOne tries to find the account with given id
+func (ca *ChartOfAccount) FindOne(id string, client *Client) (*ChartOfAccount, error) {
+	return ca.FindOneWithContext(context.Background(), id, client)
+}
+
+// FindOneWithContext tries to find the account with given id using the given context
+func (ca *ChartOfAccount) FindOneWithContext(ctx context.Context, id string, client *Client) (*ChartOfAccount, error) {
+	resp, err := client.GetWithContext(ctx, ca.Endpoint()+"/"+id)
+	respData, err := SendResp(resp, err, ca)
+	if err != nil {
+		return ca, err
+	}
+	return &respData.ChartOfAccount, err
+}
+
+// FindAll tries to find the accounts with given options
+func (ca *ChartOfAccount) FindAll(opts *ChartOfAccountFindOptions, client *Client) ([]ChartOfAccount, error) {
+	return ca.FindAllWithContext(context.Background(), opts, client)
+}
+
+// FindAllWithContext tries to find the accounts with given options using the given context
+func (ca *ChartOfAccount) FindAllWithContext(ctx context.Context, opts *ChartOfAccountFindOptions, client *Client) ([]ChartOfAccount, error) {
+	resp, err := client.GetWithContext(ctx, withQuery(ca.Endpoint(), opts))
+	respData, err := SendResp(resp, err, ca)
+
+	var results []ChartOfAccount
+	if err != nil {
+		return results, err
+	}
+	for _, acc := range respData.ChartOfAccounts {
+		results = append(results, acc)
+	}
+	return results, err
+}
+
+// Iterator returns an iterator over all the pages of accounts with given options
+func (ca *ChartOfAccount) Iterator(opts *ChartOfAccountFindOptions, client *Client) *Iterator[ChartOfAccount] {
+	return NewIterator(func(ctx context.Context, page, perPage int) ([]ChartOfAccount, *PageContext, error) {
+		resp, err := client.GetWithContext(ctx, withPage(ca.Endpoint(), opts, page, perPage))
+		respData, err := SendResp(resp, err, ca)
+		if err != nil {
+			return nil, nil, err
+		}
+		return respData.ChartOfAccounts, &respData.PageContext, nil
+	})
+}
+
+// Update method will try to update an account on zohobooks
+func (ca *ChartOfAccount) Update(id string, params *ChartOfAccountParams, client *Client) (*ChartOfAccount, error) {
+	return ca.UpdateWithContext(context.Background(), id, params, client)
+}
+
+// UpdateWithContext will try to update an account using the given context
+func (ca *ChartOfAccount) UpdateWithContext(ctx context.Context, id string, params *ChartOfAccountParams, client *Client) (*ChartOfAccount, error) {
+	var body, _ = json.Marshal(params)
+	resp, err := client.PutWithContext(ctx, ca.Endpoint()+"/"+id, string(body))
+
+	respData, err := SendResp(resp, err, ca)
+	if err != nil {
+		return ca, err
+	}
+	return &respData.ChartOfAccount, err
+}
+
+// Delete tries to delete the account with given id
+func (ca *ChartOfAccount) Delete(id string, client *Client) error {
+	return ca.DeleteWithContext(context.Background(), id, client)
+}
+
+// DeleteWithContext tries to delete the account with given id using the given context
+func (ca *ChartOfAccount) DeleteWithContext(ctx context.Context, id string, client *Client) error {
+	resp, err := client.DeleteWithContext(ctx, ca.Endpoint()+"/"+id)
+	_, err = SendResp(resp, err, ca)
+	return err
+}
+
+// MarkActive marks the account with given id as active
+func (ca *ChartOfAccount) MarkActive(id string, client *Client) error {
+	return ca.MarkActiveWithContext(context.Background(), id, client)
+}
+
+// MarkActiveWithContext marks the account with given id as active using the given context
+func (ca *ChartOfAccount) MarkActiveWithContext(ctx context.Context, id string, client *Client) error {
+	resp, err := client.postAction(ctx, ca.Endpoint()+"/"+id+"/active")
+	_, err = SendResp(resp, err, ca)
+	return err
+}
+
+// MarkInactive marks the account with given id as inactive
+func (ca *ChartOfAccount) MarkInactive(id string, client *Client) error {
+	return ca.MarkInactiveWithContext(context.Background(), id, client)
+}
+
+// MarkInactiveWithContext marks the account with given id as inactive using the given context
+func (ca *ChartOfAccount) MarkInactiveWithContext(ctx context.Context, id string, client *Client) error {
+	resp, err := client.postAction(ctx, ca.Endpoint()+"/"+id+"/inactive")
+	_, err = SendResp(resp, err, ca)
+	return err
+}
+
+// FindTransactions returns the transactions of the account set in the options
+func (ca *ChartOfAccount) FindTransactions(opts *AccountTransactionFindOptions, client *Client) ([]AccountTransaction, error) {
+	return ca.FindTransactionsWithContext(context.Background(), opts, client)
+}
+
+// FindTransactionsWithContext returns the transactions of the account set in
+// the options using the given context
+func (ca *ChartOfAccount) FindTransactionsWithContext(ctx context.Context, opts *AccountTransactionFindOptions, client *Client) ([]AccountTransaction, error) {
+	resp, err := client.GetWithContext(ctx, withQuery(ca.Endpoint()+"/transactions", opts))
+	respData, err := SendResp(resp, err, ca)
+
+	var results []AccountTransaction
+	if err != nil {
+		return results, err
+	}
+	for _, txn := range respData.AccountTransactions {
+		results = append(results, txn)
+	}
+	return results, err
+}
+
+// TransactionIterator returns an iterator over all the pages of transactions
+// of the account set in the options
+func (ca *ChartOfAccount) TransactionIterator(opts *AccountTransactionFindOptions, client *Client) *Iterator[AccountTransaction] {
+	return NewIterator(func(ctx context.Context, page, perPage int) ([]AccountTransaction, *PageContext, error) {
+		resp, err := client.GetWithContext(ctx, withPage(ca.Endpoint()+"/transactions", opts, page, perPage))
+		respData, err := SendResp(resp, err, ca)
+		if err != nil {
+			return nil, nil, err
+		}
+		return respData.AccountTransactions, &respData.PageContext, nil
+	})
+}
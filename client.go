@@ -2,12 +2,19 @@ package zohobooks
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
 	"io/ioutil"
+	"mime/multipart"
 	"net/http"
 	"net/url"
+	"os"
+	"path/filepath"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -35,6 +42,12 @@ type Client struct {
 	OrgID        string
 	Datacenter   string
 	httpClient   *http.Client
+
+	tokenStore TokenStore
+	retry      *RetryPolicy
+	limiter    *RateLimiter
+	tokenOnce  sync.Once
+	tokens     *tokenState
 }
 
 type ClientConfig struct {
@@ -46,6 +59,18 @@ type ClientConfig struct {
 	Datacenter   string
 	OrgID        string
 	Timeout      int
+
+	// TokenStore is used to load and persist access tokens, when nil an in
+	// memory store is used. OAuthToken is used until the store holds a token
+	TokenStore TokenStore
+
+	// Retry configures retrying of transient failures, when nil requests are
+	// not retried
+	Retry *RetryPolicy
+
+	// RateLimiter throttles the requests made for OrgID, it can be shared by
+	// the clients of several organizations. When nil requests are not throttled
+	RateLimiter *RateLimiter
 }
 
 type OAuthResponse struct {
@@ -54,6 +79,8 @@ type OAuthResponse struct {
 	APIDomain   string `json:"api_domain"`
 	TokenType   string `json:"token_type"`
 	ExpiresIn   int    `json:"expires_in"`
+
+	RefreshToken string `json:"refresh_token"`
 }
 
 // Response struct to handle zohobooks response
@@ -63,6 +90,33 @@ type Response struct {
 	Contact Contact `json:"contact"`
 	Invoice Invoice `json:"invoice"`
 	Payment Payment `json:"payment"`
+	Item    Item    `json:"item"`
+
+	Estimate   Estimate   `json:"estimate"`
+	SalesOrder SalesOrder `json:"salesorder"`
+	Bill       Bill       `json:"bill"`
+
+	PurchaseOrder PurchaseOrder `json:"purchaseorder"`
+	CreditNote    CreditNote    `json:"creditnote"`
+	Expense       Expense       `json:"expense"`
+
+	RecurringInvoice RecurringInvoice `json:"recurring_invoice"`
+	RetainerInvoice  RetainerInvoice  `json:"retainerinvoice"`
+	ChartOfAccount   ChartOfAccount   `json:"chart_of_account"`
+	Journal          Journal          `json:"journal"`
+	Tax              Tax              `json:"tax"`
+	TaxGroup         TaxGroup         `json:"tax_group"`
+	TaxAuthority     TaxAuthority     `json:"tax_authority"`
+	TaxExemption     TaxExemption     `json:"tax_exemption"`
+	Currency         Currency         `json:"currency"`
+	ExchangeRate     ExchangeRate     `json:"exchange_rate"`
+	Organization     Organization     `json:"organization"`
+	User             User             `json:"user"`
+
+	CreditNoteRefund CreditNoteRefund `json:"creditnote_refund"`
+	PaymentRefund    PaymentRefund    `json:"payment_refund"`
+
+	VendorPayment VendorPayment `json:"vendorpayment"`
 
 	BankTransaction BankTransaction `json:"banktransaction"`
 
@@ -70,16 +124,39 @@ type Response struct {
 	Payments     []Payment     `json:"customerpayments"`
 	Currencies   []Currency    `json:"currencies"`
 	BankAccounts []BankAccount `json:"bankaccounts"`
-	Data         zohoRespError `json:"data"`
+	Items        []Item        `json:"items"`
+	Estimates    []Estimate    `json:"estimates"`
+	SalesOrders  []SalesOrder  `json:"salesorders"`
+	Bills        []Bill        `json:"bills"`
+
+	VendorPayments []VendorPayment `json:"vendorpayments"`
+	PurchaseOrders []PurchaseOrder `json:"purchaseorders"`
+	CreditNotes    []CreditNote    `json:"creditnotes"`
+	Expenses       []Expense       `json:"expenses"`
+	Invoices       []Invoice       `json:"invoices"`
+
+	RecurringInvoices []RecurringInvoice `json:"recurring_invoices"`
+	RetainerInvoices  []RetainerInvoice  `json:"retainerinvoices"`
+	ChartOfAccounts   []ChartOfAccount   `json:"chartofaccounts"`
+	Journals          []Journal          `json:"journals"`
+	Taxes             []Tax              `json:"taxes"`
+	TaxAuthorities    []TaxAuthority     `json:"tax_authorities"`
+	TaxExemptions     []TaxExemption     `json:"tax_exemptions"`
+	ExchangeRates     []ExchangeRate     `json:"exchange_rates"`
+	Organizations     []Organization     `json:"organizations"`
+	Users             []User             `json:"users"`
+
+	AccountTransactions []AccountTransaction `json:"transactions"`
+
+	CreditNoteRefunds []CreditNoteRefund `json:"creditnote_refunds"`
+	PaymentRefunds    []PaymentRefund    `json:"payment_refunds"`
+
+	Data        zohoRespError `json:"data"`
+	PageContext PageContext   `json:"page_context"`
 }
 
 type zohoRespError struct {
-	Errors []response2 `json:"errors"`
-}
-
-type response2 struct {
-	Code    int    `json:"code"`
-	Message string `json:"message"`
+	Errors []ErrorDetail `json:"errors"`
 }
 
 // Resource interface is to be used for generic decoding of object
@@ -122,6 +199,12 @@ func NewClientWithConfig(conf *ClientConfig) *Client {
 		clientSecret: conf.ClientSecret,
 		redirectURI:  conf.RedirectURI,
 		refreshToken: conf.RefreshToken,
+		tokenStore:   conf.TokenStore,
+		retry:        conf.Retry,
+		limiter:      conf.RateLimiter,
+	}
+	if c.tokenStore == nil {
+		c.tokenStore = NewMemoryTokenStore(nil)
 	}
 	c.httpClient = getHTTPClient(conf.Timeout)
 	return c
@@ -149,6 +232,10 @@ func getHTTPClient(timeout int) *http.Client {
 	return httpClient
 }
 
+// maxBodySnippet is the number of bytes of an unexpected response body
+// included in the returned error
+const maxBodySnippet = 256
+
 func SendResp(resp *http.Response, err error, rs Resource) (*Response, error) {
 	var newResp = &Response{}
 	if err != nil {
@@ -158,11 +245,113 @@ func SendResp(resp *http.Response, err error, rs Resource) (*Response, error) {
 	if readErr != nil {
 		return newResp, readErr
 	}
+	var isJSON = isJSONResponse(resp, body)
+	if resp.StatusCode >= http.StatusBadRequest {
+		if isJSON && json.Unmarshal(body, newResp) == nil && newResp.Code > 0 {
+			return newResp, newAPIError(resp, newResp.Code, newResp.Message, newResp.Data.Errors)
+		}
+		var msg = http.StatusText(resp.StatusCode)
+		if snippet := bodySnippet(body); len(snippet) > 0 {
+			msg += ": " + snippet
+		}
+		return newResp, newAPIError(resp, 0, msg, nil)
+	}
+	if len(bytes.TrimSpace(body)) == 0 {
+		return newResp, fmt.Errorf("zohobooks: empty response body with status %d", resp.StatusCode)
+	}
+	if !isJSON {
+		return newResp, fmt.Errorf("zohobooks: unexpected content type %q: %s", resp.Header.Get("Content-Type"), bodySnippet(body))
+	}
 	parseError := json.Unmarshal(body, newResp)
-	if parseError == nil && newResp.Code > 0 {
-		return newResp, errors.New(newResp.Message)
+	if parseError != nil {
+		return newResp, fmt.Errorf("zohobooks: decoding response: %w: %s", parseError, bodySnippet(body))
+	}
+	if newResp.Code > 0 {
+		return newResp, newAPIError(resp, newResp.Code, newResp.Message, newResp.Data.Errors)
+	}
+	return newResp, nil
+}
+
+// isJSONResponse reports whether the response body is JSON, by its content
+// type or, when the content type is missing, by sniffing the body
+func isJSONResponse(resp *http.Response, body []byte) bool {
+	var contentType = resp.Header.Get("Content-Type")
+	if len(contentType) > 0 {
+		return strings.Contains(strings.ToLower(contentType), "json")
+	}
+	return bytes.HasPrefix(bytes.TrimSpace(body), []byte("{"))
+}
+
+// bodySnippet returns the start of the body to be included in errors
+func bodySnippet(body []byte) string {
+	body = bytes.TrimSpace(body)
+	if len(body) > maxBodySnippet {
+		return string(body[:maxBodySnippet]) + "..."
+	}
+	return string(body)
+}
+
+// checkFileResp returns an error if the response of a file download is an
+// error response instead of the file contents
+func checkFileResp(resp *http.Response) error {
+	var contentType = strings.ToLower(resp.Header.Get("Content-Type"))
+	if resp.StatusCode < http.StatusBadRequest && !strings.Contains(contentType, "json") {
+		return nil
+	}
+	if _, err := SendResp(resp, nil, nil); err != nil {
+		return err
+	}
+	return fmt.Errorf("zohobooks: expected a file, got content type %q", contentType)
+}
+
+// download writes the file at the given path of the API to the filepath
+func (c *Client) download(ctx context.Context, path, filepath string) error {
+	resp, err := c.GetWithContext(ctx, path)
+	if err != nil {
+		return err
+	}
+	if err := checkFileResp(resp); err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// Create the file
+	f, err := os.Create(filepath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	// Writer the body to file
+	_, err = io.Copy(f, resp.Body)
+	return err
+}
+
+// upload sends the file at filename to the given path of the API as a
+// multipart form with the given field name
+func (c *Client) upload(ctx context.Context, path, field, filename string) (*http.Response, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+	part, err := w.CreateFormFile(field, filepath.Base(filename))
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.Copy(part, f); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	headers := map[string]string{
+		"Content-Type": w.FormDataContentType(),
 	}
-	return newResp, parseError
+	return c.makeRequest(ctx, "POST", path, buf.Bytes(), headers)
 }
 
 func readBody(resp *http.Response) ([]byte, error) {
@@ -176,64 +365,138 @@ func readBody(resp *http.Response) ([]byte, error) {
 }
 
 func (c *Client) getURL(path string) string {
-	var baseURL = c.GetBaseURL()
-	if strings.Contains(path, "?") {
-		return baseURL + path + "&organization_id=" + c.OrgID
+	var query = url.Values{}
+	if len(c.OrgID) > 0 {
+		query.Set("organization_id", c.OrgID)
 	}
-	return baseURL + path + "?organization_id=" + c.OrgID
+	return c.GetBaseURL() + appendQuery(path, query)
 }
 
-func (c *Client) makeRequest(method, path string, body *bytes.Buffer, headers map[string]string) (*http.Response, error) {
-	if len(c.OAuthToken) == 0 || len(c.OrgID) == 0 {
+func (c *Client) makeRequest(ctx context.Context, method, path string, body []byte, headers map[string]string) (*http.Response, error) {
+	token, err := c.accessToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// the organizations endpoint is used to discover the org id so it is
+	// the only one that can be called without it
+	if len(token) == 0 || (len(c.OrgID) == 0 && !strings.HasPrefix(path, "/organizations")) {
 		return nil, errors.New("missing oauthtoken or org id")
 	}
-	req, _ := http.NewRequest(method, c.getURL(path), body)
+	resp, err := c.doWithRetry(ctx, method, path, body, headers, token)
+	if err != nil || !c.canRefresh() || !isInvalidTokenResp(resp) {
+		return resp, err
+	}
+
+	// the access token was rejected, refresh it and retry the request once
+	resp.Body.Close()
+	token, err = c.refreshAccessToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	return c.doWithRetry(ctx, method, path, body, headers, token)
+}
+
+// isInvalidTokenResp reports whether zohobooks rejected the request because
+// the access token is invalid or expired. Other 401 responses, such as code 57
+// for an operation the user may not perform, are not fixed by a new token. The
+// body is read and replaced so that it can still be decoded by the caller
+func isInvalidTokenResp(resp *http.Response) bool {
+	if resp.StatusCode != http.StatusUnauthorized {
+		return false
+	}
+	body, err := readBody(resp)
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return false
+	}
+	var errResp Response
+	if json.Unmarshal(body, &errResp) != nil {
+		return false
+	}
+	return errResp.Code == ErrCodeInvalidToken
+}
+
+func (c *Client) doRequest(ctx context.Context, method, path string, body []byte, headers map[string]string, token string) (*http.Response, error) {
+	if c.limiter != nil {
+		if err := c.limiter.Wait(ctx, c.OrgID); err != nil {
+			return nil, err
+		}
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.getURL(path), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
 	for k, v := range headers {
 		req.Header.Set(k, v)
 	}
 
-	if len(c.OAuthToken) > 0 {
-		req.Header.Set("Authorization", "Zoho-oauthtoken "+c.OAuthToken)
+	if len(token) > 0 {
+		req.Header.Set("Authorization", "Zoho-oauthtoken "+token)
 	} else if len(c.Key) > 0 { // Zoho authtoken are deprecated use oauthtokens
 		req.Header.Set("Authorization", "Zoho-authtoken "+c.Key)
 	}
-	resp, err := c.httpClient.Do(req)
-	return resp, err
+	return c.httpClient.Do(req)
 }
 
 // Get method makes a GET request to the resource
 func (c *Client) Get(path string) (*http.Response, error) {
-	return c.makeRequest("GET", path, bytes.NewBuffer([]byte("")), nil)
+	return c.GetWithContext(context.Background(), path)
+}
+
+// GetWithContext makes a GET request to the resource using the given context
+func (c *Client) GetWithContext(ctx context.Context, path string) (*http.Response, error) {
+	return c.makeRequest(ctx, "GET", path, nil, nil)
 }
 
 // Post method makes a POST and sends data in json format
 func (c *Client) Post(path string, body string) (*http.Response, error) {
+	return c.PostWithContext(context.Background(), path, body)
+}
+
+// PostWithContext makes a POST and sends data in json format using the given context
+func (c *Client) PostWithContext(ctx context.Context, path string, body string) (*http.Response, error) {
 	headers := map[string]string{
 		"Content-Type": "application/x-www-form-urlencoded;charset=UTF-8",
 	}
 	data := url.Values{}
 	data.Set("JSONString", body)
 	byteBody := []byte(data.Encode())
-	return c.makeRequest("POST", path, bytes.NewBuffer(byteBody), headers)
+	return c.makeRequest(ctx, "POST", path, byteBody, headers)
 }
 
 // Put method makes a PUT and sends data in json format
 func (c *Client) Put(path string, body string) (*http.Response, error) {
+	return c.PutWithContext(context.Background(), path, body)
+}
+
+// PutWithContext makes a PUT and sends data in json format using the given context
+func (c *Client) PutWithContext(ctx context.Context, path string, body string) (*http.Response, error) {
 	headers := map[string]string{
 		"Content-Type": "application/x-www-form-urlencoded;charset=UTF-8",
 	}
 	data := url.Values{}
 	data.Set("JSONString", body)
 	byteBody := []byte(data.Encode())
-	return c.makeRequest("PUT", path, bytes.NewBuffer(byteBody), headers)
+	return c.makeRequest(ctx, "PUT", path, byteBody, headers)
 }
 
 // Delete method makes a DELETE request to the resource
 func (c *Client) Delete(path string) (*http.Response, error) {
+	return c.DeleteWithContext(context.Background(), path)
+}
+
+// DeleteWithContext makes a DELETE request to the resource using the given context
+func (c *Client) DeleteWithContext(ctx context.Context, path string) (*http.Response, error) {
 	headers := map[string]string{
 		"Content-Type": "application/x-www-form-urlencoded;charset=UTF-8",
 	}
-	return c.makeRequest("DELETE", path, bytes.NewBuffer([]byte("")), headers)
+	return c.makeRequest(ctx, "DELETE", path, nil, headers)
+}
+
+// postAction makes a POST request without a body, it is used for the actions
+// of the resources such as marking an item as active
+func (c *Client) postAction(ctx context.Context, path string) (*http.Response, error) {
+	return c.makeRequest(ctx, "POST", path, nil, nil)
 }
 
 func (c *Client) GetOauthURL() string {
@@ -250,26 +513,29 @@ func (c *Client) GetOauthURL() string {
 }
 
 func (c *Client) GenAccessToken() (string, error) {
-	var query = "refresh_token=" + c.refreshToken + "&client_id=" + c.clientID + "&client_secret=" + c.clientSecret + "&redirect_uri=" + c.redirectURI + "&grant_type=refresh_token"
-	req, err := http.NewRequest("POST", c.GetOauthURL()+"?"+query, nil)
-	if err != nil {
-		return "", err
-	}
-	resp, err := c.httpClient.Do(req)
+	return c.GenAccessTokenWithContext(context.Background())
+}
+
+// GenAccessTokenWithContext generates a new access token from the refresh token
+// using the given context
+func (c *Client) GenAccessTokenWithContext(ctx context.Context) (string, error) {
+	oauthResp, err := c.requestAccessToken(ctx)
 	if err != nil {
 		return "", err
 	}
-	body, readErr := readBody(resp)
-	if readErr != nil {
-		return "", readErr
-	}
-	oauthResp := &OAuthResponse{}
-	parseError := json.Unmarshal(body, oauthResp)
-	if parseError != nil {
-		return "", parseError
-	}
-	if oauthResp.Error != "" {
-		return "", errors.New(oauthResp.Error)
-	}
 	return oauthResp.AccessToken, nil
 }
+
+func (c *Client) requestAccessToken(ctx context.Context) (*OAuthResponse, error) {
+	c.state().mu.Lock()
+	refreshToken := c.refreshToken
+	c.state().mu.Unlock()
+
+	var params = url.Values{}
+	params.Set("refresh_token", refreshToken)
+	params.Set("client_id", c.clientID)
+	params.Set("client_secret", c.clientSecret)
+	params.Set("redirect_uri", c.redirectURI)
+	params.Set("grant_type", "refresh_token")
+	return c.oauthRequest(ctx, c.GetOauthURL(), params)
+}
@@ -1,8 +1,10 @@
 package zohobooks
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
+	"net/url"
 )
 
 type ContactPerson struct {
@@ -62,6 +64,17 @@ type BillingAddress struct {
 
 type ContactFindOptions struct {
 	EmailContains string
+	ListOptions
+}
+
+// Values encodes the options as query parameters
+func (o *ContactFindOptions) Values() url.Values {
+	if o == nil {
+		return url.Values{}
+	}
+	var v = o.ListOptions.Values()
+	setString(v, "email_contains", o.EmailContains)
+	return v
 }
 
 // ContactParams struct represents the information to create a contact
@@ -107,8 +120,13 @@ func (c *Contact) Endpoint() string {
 
 // Create method will try to create a contact on razorpay
 func (c *Contact) Create(params *ContactParams, client *Client) (*Contact, error) {
+	return c.CreateWithContext(context.Background(), params, client)
+}
+
+// CreateWithContext will try to create a contact using the given context
+func (c *Contact) CreateWithContext(ctx context.Context, params *ContactParams, client *Client) (*Contact, error) {
 	var body, _ = json.Marshal(params)
-	resp, err := client.Post(c.Endpoint(), string(body))
+	resp, err := client.PostWithContext(ctx, c.Endpoint(), string(body))
 
 	respData, err := SendResp(resp, err, c)
 	if err != nil {
@@ -119,7 +137,12 @@ func (c *Contact) Create(params *ContactParams, client *Client) (*Contact, error
 
 // FindOne tries to find the contact with given id
 func (c *Contact) FindOne(id string, client *Client) (*Contact, error) {
-	resp, err := client.Get(c.Endpoint() + "/" + id)
+	return c.FindOneWithContext(context.Background(), id, client)
+}
+
+// FindOneWithContext tries to find the contact with given id using the given context
+func (c *Contact) FindOneWithContext(ctx context.Context, id string, client *Client) (*Contact, error) {
+	resp, err := client.GetWithContext(ctx, c.Endpoint()+"/"+id)
 	respData, err := SendResp(resp, err, c)
 	if err != nil {
 		return c, err
@@ -129,7 +152,12 @@ func (c *Contact) FindOne(id string, client *Client) (*Contact, error) {
 
 // FindAll tries to find the contacts with given options
 func (c *Contact) FindAll(opts *ContactFindOptions, client *Client) ([]Contact, error) {
-	resp, err := client.Get(c.Endpoint() + "?email_contains=" + opts.EmailContains)
+	return c.FindAllWithContext(context.Background(), opts, client)
+}
+
+// FindAllWithContext tries to find the contacts with given options using the given context
+func (c *Contact) FindAllWithContext(ctx context.Context, opts *ContactFindOptions, client *Client) ([]Contact, error) {
+	resp, err := client.GetWithContext(ctx, withQuery(c.Endpoint(), opts))
 	respData, err := SendResp(resp, err, c)
 
 	var results []Contact
@@ -142,10 +170,27 @@ func (c *Contact) FindAll(opts *ContactFindOptions, client *Client) ([]Contact,
 	return results, err
 }
 
+// Iterator returns an iterator over all the pages of contacts with given options
+func (c *Contact) Iterator(opts *ContactFindOptions, client *Client) *Iterator[Contact] {
+	return NewIterator(func(ctx context.Context, page, perPage int) ([]Contact, *PageContext, error) {
+		resp, err := client.GetWithContext(ctx, withPage(c.Endpoint(), opts, page, perPage))
+		respData, err := SendResp(resp, err, c)
+		if err != nil {
+			return nil, nil, err
+		}
+		return respData.Contacts, &respData.PageContext, nil
+	})
+}
+
 // Update method will try to update a invoice on razorpay
 func (c *Contact) Update(id string, params *ContactParams, client *Client) (*Contact, error) {
+	return c.UpdateWithContext(context.Background(), id, params, client)
+}
+
+// UpdateWithContext will try to update a contact using the given context
+func (c *Contact) UpdateWithContext(ctx context.Context, id string, params *ContactParams, client *Client) (*Contact, error) {
 	var body, _ = json.Marshal(params)
-	resp, err := client.Put(c.Endpoint()+"/"+id, string(body))
+	resp, err := client.PutWithContext(ctx, c.Endpoint()+"/"+id, string(body))
 
 	respData, err := SendResp(resp, err, c)
 	if err != nil {
@@ -156,7 +201,12 @@ func (c *Contact) Update(id string, params *ContactParams, client *Client) (*Con
 
 // Delete tries to delete the contact with given id
 func (c *Contact) Delete(id string, client *Client) error {
-	resp, err := client.Delete(c.Endpoint() + "/" + id)
+	return c.DeleteWithContext(context.Background(), id, client)
+}
+
+// DeleteWithContext tries to delete the contact with given id using the given context
+func (c *Contact) DeleteWithContext(ctx context.Context, id string, client *Client) error {
+	resp, err := client.DeleteWithContext(ctx, c.Endpoint()+"/"+id)
 	respData, err := SendResp(resp, err, c)
 	if err != nil {
 		return err
@@ -0,0 +1,351 @@
+package zohobooks
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+)
+
+// CreditInfo struct contains the credits of a credit note applied to an invoice
+type CreditInfo struct {
+	CreditNoteID     string  `json:"creditnote_id"`
+	CreditNoteNumber string  `json:"creditnotes_number"`
+	CreditedDate     string  `json:"credited_date"`
+	AmountApplied    float64 `json:"amount_applied"`
+}
+
+// CreditNote struct represents the information of a credit note
+type CreditNote struct {
+	ID               string   `json:"creditnote_id"`
+	CreditNoteNumber string   `json:"creditnote_number"`
+	CustomerID       string   `json:"customer_id"`
+	CustomerName     string   `json:"customer_name"`
+	ContactPersons   []string `json:"contact_persons"`
+	InvoiceID        string   `json:"invoice_id"`
+	PlaceOfSupply    string   `json:"place_of_supply"`
+
+	// possible values ---> vat_registered,vat_not_registered,gcc_vat_not_registered,gcc_vat_registered,non_gcc,dz_vat_registered and dz_vat_not_registered.
+	TaxTreatment string `json:"tax_treatment"`
+	GstNO        string `json:"gst_no"`        // 15 digit
+	GstTreatment string `json:"gst_treatment"` // Allowed values are business_gst , business_none , overseas , consumer
+
+	Status         string     `json:"status"` // draft, open, closed or void
+	Date           string     `json:"date"`
+	CurrencyCode   string     `json:"currency_code"`
+	CurrencyID     string     `json:"currency_id"`
+	ExchangeRate   float64    `json:"exchange_rate"`
+	IsInclusiveTax bool       `json:"is_inclusive_tax"`
+	RefNo          string     `json:"reference_number"`
+	LineItems      []LineItem `json:"line_items"`
+	Notes          string     `json:"notes"`
+	Terms          string     `json:"terms"`
+	BranchID       string     `json:"branch_id"`
+
+	SubTotal float64   `json:"sub_total"`
+	TaxTotal float64   `json:"tax_total"`
+	Total    float64   `json:"total"`
+	Balance  float64   `json:"balance"`
+	Taxes    []taxInfo `json:"taxes"`
+
+	CreatedTime      string `json:"created_time"`
+	LastModifiedTime string `json:"last_modified_time"`
+
+	BillingAddress  BillingAddress `json:"billing_address"`
+	ShippingAddress BillingAddress `json:"shipping_address"`
+}
+
+// CreditNoteParams struct represents the information to create a credit note
+type CreditNoteParams struct {
+	CustomerID       string   `json:"customer_id"`
+	ContactPersons   []string `json:"contact_persons,omitempty"`
+	CreditNoteNumber string   `json:"creditnote_number,omitempty"`
+	InvoiceID        string   `json:"invoice_id,omitempty"`
+	ReferenceNo      string   `json:"reference_number,omitempty"`
+	PlaceOfSupply    string   `json:"place_of_supply,omitempty"`
+
+	// possible values ---> vat_registered,vat_not_registered,gcc_vat_not_registered,gcc_vat_registered,non_gcc,dz_vat_registered and dz_vat_not_registered.
+	TaxTreatment string `json:"tax_treatment,omitempty"`
+	GstNO        string `json:"gst_no,omitempty"`        // 15 digit
+	GstTreatment string `json:"gst_treatment,omitempty"` // Allowed values are business_gst , business_none , overseas , consumer
+
+	Date           string     `json:"date"`
+	ExchangeRate   float64    `json:"exchange_rate,omitempty"`
+	IsInclusiveTax bool       `json:"is_inclusive_tax"`
+	LineItems      []LineItem `json:"line_items"`
+	Notes          string     `json:"notes,omitempty"`
+	Terms          string     `json:"terms,omitempty"`
+	BranchID       string     `json:"branch_id,omitempty"`
+}
+
+// CreditNoteFindOptions struct contains the filters used to list credit notes
+type CreditNoteFindOptions struct {
+	CustomerID       string
+	CreditNoteNumber string
+	ReferenceNo      string
+	Status           string
+	ListOptions
+}
+
+// Values encodes the options as query parameters
+func (o *CreditNoteFindOptions) Values() url.Values {
+	if o == nil {
+		return url.Values{}
+	}
+	var v = o.ListOptions.Values()
+	setString(v, "customer_id", o.CustomerID)
+	setString(v, "creditnote_number", o.CreditNoteNumber)
+	setString(v, "reference_number", o.ReferenceNo)
+	setString(v, "status", o.Status)
+	return v
+}
+
+// CreditNoteRefund struct represents the amount of a credit note refunded to
+// the customer
+type CreditNoteRefund struct {
+	ID               string  `json:"creditnote_refund_id"`
+	CreditNoteID     string  `json:"creditnote_id"`
+	CreditNoteNumber string  `json:"creditnote_number"`
+	CustomerName     string  `json:"customer_name"`
+	Date             string  `json:"date"`
+	RefundMode       string  `json:"refund_mode"`
+	RefNo            string  `json:"reference_number"`
+	Amount           float64 `json:"amount_bcy"`
+	AmountFCY        float64 `json:"amount_fcy"`
+	ExchangeRate     float64 `json:"exchange_rate"`
+	FromAccountID    string  `json:"from_account_id"`
+	FromAccountName  string  `json:"from_account_name"`
+	Description      string  `json:"description"`
+}
+
+// CreditNoteRefundParams struct represents the information to refund a credit note
+type CreditNoteRefundParams struct {
+	Date          string  `json:"date"`
+	RefundMode    string  `json:"refund_mode,omitempty"`
+	RefNo         string  `json:"reference_number,omitempty"`
+	Amount        float64 `json:"amount"`
+	ExchangeRate  float64 `json:"exchange_rate,omitempty"`
+	FromAccountID string  `json:"from_account_id"`
+	Description   string  `json:"description,omitempty"`
+}
+
+// creditNoteInvoices is the body used to apply credits to invoices
+type creditNoteInvoices struct {
+	Invoices []InvoiceInfo `json:"invoices"`
+}
+
+// New method will create a credit note object and return a pointer to it
+func (cn *CreditNote) New() Resource {
+	var obj = &CreditNote{}
+	return obj
+}
+
+// Endpoint method returns the endpoint of the resource
+func (cn *CreditNote) Endpoint() string {
+	return "/creditnotes"
+}
+
+// Create method will try to create a credit note on zohobooks
+func (cn *CreditNote) Create(params *CreditNoteParams, client *Client) (*CreditNote, error) {
+	return cn.CreateWithContext(context.Background(), params, client)
+}
+
+// CreateWithContext will try to create a credit note using the given context
+func (cn *CreditNote) CreateWithContext(ctx context.Context, params *CreditNoteParams, client *Client) (*CreditNote, error) {
+	var body, _ = json.Marshal(params)
+	resp, err := client.PostWithContext(ctx, cn.Endpoint(), string(body))
+
+	respData, err := SendResp(resp, err, cn)
+	if err != nil {
+		return cn, err
+	}
+	return &respData.CreditNote, err
+}
+
+// FindOne tries to find the credit note with given id
+func (cn *CreditNote) FindOne(id string, client *Client) (*CreditNote, error) {
+	return cn.FindOneWithContext(context.Background(), id, client)
+}
+
+// FindOneWithContext tries to find the credit note with given id using the given context
+func (cn *CreditNote) FindOneWithContext(ctx context.Context, id string, client *Client) (*CreditNote, error) {
+	resp, err := client.GetWithContext(ctx, cn.Endpoint()+"/"+id)
+	respData, err := SendResp(resp, err, cn)
+	if err != nil {
+		return cn, err
+	}
+	return &respData.CreditNote, err
+}
+
+// FindAll tries to find the credit notes with given options
+func (cn *CreditNote) FindAll(opts *CreditNoteFindOptions, client *Client) ([]CreditNote, error) {
+	return cn.FindAllWithContext(context.Background(), opts, client)
+}
+
+// FindAllWithContext tries to find the credit notes with given options using the given context
+func (cn *CreditNote) FindAllWithContext(ctx context.Context, opts *CreditNoteFindOptions, client *Client) ([]CreditNote, error) {
+	resp, err := client.GetWithContext(ctx, withQuery(cn.Endpoint(), opts))
+	respData, err := SendResp(resp, err, cn)
+
+	var results []CreditNote
+	if err != nil {
+		return results, err
+	}
+	for _, note := range respData.CreditNotes {
+		results = append(results, note)
+	}
+	return results, err
+}
+
+// Iterator returns an iterator over all the pages of credit notes with given options
+func (cn *CreditNote) Iterator(opts *CreditNoteFindOptions, client *Client) *Iterator[CreditNote] {
+	return NewIterator(func(ctx context.Context, page, perPage int) ([]CreditNote, *PageContext, error) {
+		resp, err := client.GetWithContext(ctx, withPage(cn.Endpoint(), opts, page, perPage))
+		respData, err := SendResp(resp, err, cn)
+		if err != nil {
+			return nil, nil, err
+		}
+		return respData.CreditNotes, &respData.PageContext, nil
+	})
+}
+
+// Update method will try to update a credit note on zohobooks
+func (cn *CreditNote) Update(id string, params *CreditNoteParams, client *Client) (*CreditNote, error) {
+	return cn.UpdateWithContext(context.Background(), id, params, client)
+}
+
+// UpdateWithContext will try to update a credit note using the given context
+func (cn *CreditNote) UpdateWithContext(ctx context.Context, id string, params *CreditNoteParams, client *Client) (*CreditNote, error) {
+	var body, _ = json.Marshal(params)
+	resp, err := client.PutWithContext(ctx, cn.Endpoint()+"/"+id, string(body))
+
+	respData, err := SendResp(resp, err, cn)
+	if err != nil {
+		return cn, err
+	}
+	return &respData.CreditNote, err
+}
+
+// Delete tries to delete the credit note with given id
+func (cn *CreditNote) Delete(id string, client *Client) error {
+	return cn.DeleteWithContext(context.Background(), id, client)
+}
+
+// DeleteWithContext tries to delete the credit note with given id using the given context
+func (cn *CreditNote) DeleteWithContext(ctx context.Context, id string, client *Client) error {
+	resp, err := client.DeleteWithContext(ctx, cn.Endpoint()+"/"+id)
+	_, err = SendResp(resp, err, cn)
+	return err
+}
+
+// MarkOpen converts the draft or void credit note with given id to open
+func (cn *CreditNote) MarkOpen(id string, client *Client) error {
+	return cn.MarkOpenWithContext(context.Background(), id, client)
+}
+
+// MarkOpenWithContext converts the credit note with given id to open using the given context
+func (cn *CreditNote) MarkOpenWithContext(ctx context.Context, id string, client *Client) error {
+	resp, err := client.postAction(ctx, cn.Endpoint()+"/"+id+"/status/open")
+	_, err = SendResp(resp, err, cn)
+	return err
+}
+
+// Void marks the credit note with given id as void
+func (cn *CreditNote) Void(id string, client *Client) error {
+	return cn.VoidWithContext(context.Background(), id, client)
+}
+
+// VoidWithContext marks the credit note with given id as void using the given context
+func (cn *CreditNote) VoidWithContext(ctx context.Context, id string, client *Client) error {
+	resp, err := client.postAction(ctx, cn.Endpoint()+"/"+id+"/status/void")
+	_, err = SendResp(resp, err, cn)
+	return err
+}
+
+// Email method will send the credit note to the customer
+func (cn *CreditNote) Email(id string, params *EmailParams, client *Client) error {
+	return cn.EmailWithContext(context.Background(), id, params, client)
+}
+
+// EmailWithContext will send the credit note to the customer using the given context
+func (cn *CreditNote) EmailWithContext(ctx context.Context, id string, params *EmailParams, client *Client) error {
+	var body, _ = json.Marshal(params)
+	resp, err := client.PostWithContext(ctx, cn.Endpoint()+"/"+id+"/email", string(body))
+
+	_, err = SendResp(resp, err, cn)
+	return err
+}
+
+// DownloadPDF method will download the pdf to the given filepath
+func (cn *CreditNote) DownloadPDF(id, filepath string, client *Client) error {
+	return cn.DownloadPDFWithContext(context.Background(), id, filepath, client)
+}
+
+// DownloadPDFWithContext will download the pdf to the given filepath using the given context
+func (cn *CreditNote) DownloadPDFWithContext(ctx context.Context, id, filepath string, client *Client) error {
+	return client.download(ctx, appendQuery(cn.Endpoint()+"/pdf", url.Values{"creditnote_ids": {id}}), filepath)
+}
+
+// ApplyToInvoices applies the credits of the credit note to the given invoices,
+// AmountApplied of each invoice is the amount credited to it
+func (cn *CreditNote) ApplyToInvoices(id string, invoices []InvoiceInfo, client *Client) error {
+	return cn.ApplyToInvoicesWithContext(context.Background(), id, invoices, client)
+}
+
+// ApplyToInvoicesWithContext applies the credits of the credit note to the
+// given invoices using the given context
+func (cn *CreditNote) ApplyToInvoicesWithContext(ctx context.Context, id string, invoices []InvoiceInfo, client *Client) error {
+	var body, _ = json.Marshal(&creditNoteInvoices{Invoices: invoices})
+	resp, err := client.PostWithContext(ctx, cn.Endpoint()+"/"+id+"/invoices", string(body))
+
+	_, err = SendResp(resp, err, cn)
+	return err
+}
+
+// Refund records a refund of the credit note with given id
+func (cn *CreditNote) Refund(id string, params *CreditNoteRefundParams, client *Client) (*CreditNoteRefund, error) {
+	return cn.RefundWithContext(context.Background(), id, params, client)
+}
+
+// RefundWithContext records a refund of the credit note with given id using the given context
+func (cn *CreditNote) RefundWithContext(ctx context.Context, id string, params *CreditNoteRefundParams, client *Client) (*CreditNoteRefund, error) {
+	var body, _ = json.Marshal(params)
+	resp, err := client.PostWithContext(ctx, cn.Endpoint()+"/"+id+"/refunds", string(body))
+
+	respData, err := SendResp(resp, err, cn)
+	if err != nil {
+		return nil, err
+	}
+	return &respData.CreditNoteRefund, err
+}
+
+// FindRefunds returns the refunds of the credit note with given id
+func (cn *CreditNote) FindRefunds(id string, client *Client) ([]CreditNoteRefund, error) {
+	return cn.FindRefundsWithContext(context.Background(), id, client)
+}
+
+// FindRefundsWithContext returns the refunds of the credit note with given id using the given context
+func (cn *CreditNote) FindRefundsWithContext(ctx context.Context, id string, client *Client) ([]CreditNoteRefund, error) {
+	resp, err := client.GetWithContext(ctx, cn.Endpoint()+"/"+id+"/refunds")
+	respData, err := SendResp(resp, err, cn)
+
+	var results []CreditNoteRefund
+	if err != nil {
+		return results, err
+	}
+	for _, refund := range respData.CreditNoteRefunds {
+		results = append(results, refund)
+	}
+	return results, err
+}
+
+// DeleteRefund deletes the refund with given id of the credit note
+func (cn *CreditNote) DeleteRefund(id, refundID string, client *Client) error {
+	return cn.DeleteRefundWithContext(context.Background(), id, refundID, client)
+}
+
+// DeleteRefundWithContext deletes the refund with given id of the credit note using the given context
+func (cn *CreditNote) DeleteRefundWithContext(ctx context.Context, id, refundID string, client *Client) error {
+	resp, err := client.DeleteWithContext(ctx, cn.Endpoint()+"/"+id+"/refunds/"+refundID)
+	_, err = SendResp(resp, err, cn)
+	return err
+}
@@ -1,5 +1,12 @@
 package zohobooks
 
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"time"
+)
+
 // Currency struct represents the information of the currency
 type Currency struct {
 	ID             string  `json:"currency_id"`
@@ -12,7 +19,15 @@ type Currency struct {
 	EffectiveDate  string  `json:"effective_date"`
 }
 
-// New method will create a contact object and return a pointer to it
+// CurrencyParams struct represents the information to create a currency
+type CurrencyParams struct {
+	Code           string `json:"currency_code"`
+	Symbol         string `json:"currency_symbol,omitempty"`
+	PricePrecision int    `json:"price_precision,omitempty"`
+	Format         string `json:"currency_format,omitempty"` // e.g. 1,234,567.89
+}
+
+// New method will create a currency object and return a pointer to it
 func (c *Currency) New() Resource {
 	var obj = &Currency{}
 	return obj
@@ -25,8 +40,13 @@ func (c *Currency) Endpoint() string {
 
 // FindAll will return the list of currencies present in zohobooks org
 func (c *Currency) FindAll(client *Client) ([]Currency, error) {
+	return c.FindAllWithContext(context.Background(), client)
+}
+
+// FindAllWithContext will return the list of currencies using the given context
+func (c *Currency) FindAllWithContext(ctx context.Context, client *Client) ([]Currency, error) {
 	var results []Currency
-	resp, err := client.Get(c.Endpoint())
+	resp, err := client.GetWithContext(ctx, c.Endpoint())
 	respData, err := SendResp(resp, err, c)
 	if err != nil {
 		return results, err
@@ -36,3 +56,194 @@ func (c *Currency) FindAll(client *Client) ([]Currency, error) {
 	}
 	return results, nil
 }
+
+// Create method will try to create a currency on zohobooks
+func (c *Currency) Create(params *CurrencyParams, client *Client) (*Currency, error) {
+	return c.CreateWithContext(context.Background(), params, client)
+}
+
+// CreateWithContext will try to create a currency using the given context
+func (c *Currency) CreateWithContext(ctx context.Context, params *CurrencyParams, client *Client) (*Currency, error) {
+	var body, _ = json.Marshal(params)
+	resp, err := client.PostWithContext(ctx, c.Endpoint(), string(body))
+
+	respData, err := SendResp(resp, err, c)
+	if err != nil {
+		return c, err
+	}
+	return &respData.Currency, err
+}
+
+// FindOne tries to find the currency with given id
+func (c *Currency) FindOne(id string, client *Client) (*Currency, error) {
+	return c.FindOneWithContext(context.Background(), id, client)
+}
+
+// FindOneWithContext tries to find the currency with given id using the given context
+func (c *Currency) FindOneWithContext(ctx context.Context, id string, client *Client) (*Currency, error) {
+	resp, err := client.GetWithContext(ctx, c.Endpoint()+"/"+id)
+	respData, err := SendResp(resp, err, c)
+	if err != nil {
+		return c, err
+	}
+	return &respData.Currency, err
+}
+
+// Update method will try to update a currency on zohobooks
+func (c *Currency) Update(id string, params *CurrencyParams, client *Client) (*Currency, error) {
+	return c.UpdateWithContext(context.Background(), id, params, client)
+}
+
+// UpdateWithContext will try to update a currency using the given context
+func (c *Currency) UpdateWithContext(ctx context.Context, id string, params *CurrencyParams, client *Client) (*Currency, error) {
+	var body, _ = json.Marshal(params)
+	resp, err := client.PutWithContext(ctx, c.Endpoint()+"/"+id, string(body))
+
+	respData, err := SendResp(resp, err, c)
+	if err != nil {
+		return c, err
+	}
+	return &respData.Currency, err
+}
+
+// Delete tries to delete the currency with given id
+func (c *Currency) Delete(id string, client *Client) error {
+	return c.DeleteWithContext(context.Background(), id, client)
+}
+
+// DeleteWithContext tries to delete the currency with given id using the given context
+func (c *Currency) DeleteWithContext(ctx context.Context, id string, client *Client) error {
+	resp, err := client.DeleteWithContext(ctx, c.Endpoint()+"/"+id)
+	_, err = SendResp(resp, err, c)
+	return err
+}
+
+// ExchangeRate struct represents the rate of a currency on an effective date
+type ExchangeRate struct {
+	ID            string  `json:"exchange_rate_id"`
+	CurrencyID    string  `json:"currency_id"`
+	CurrencyCode  string  `json:"currency_code"`
+	EffectiveDate string  `json:"effective_date"`
+	Rate          float64 `json:"rate"`
+}
+
+// ExchangeRateParams struct represents the information to create an exchange rate
+type ExchangeRateParams struct {
+	EffectiveDate string  `json:"effective_date"` // yyyy-mm-dd
+	Rate          float64 `json:"rate"`
+}
+
+// ExchangeRateFindOptions struct contains the filters for listing exchange rates
+type ExchangeRateFindOptions struct {
+	FromDate      time.Time
+	IsCurrentDate bool // only return the rate effective today
+}
+
+// Values encodes the options as query parameters, zero values are omitted
+func (o *ExchangeRateFindOptions) Values() url.Values {
+	var v = url.Values{}
+	if o == nil {
+		return v
+	}
+	setDate(v, "from_date", o.FromDate)
+	if o.IsCurrentDate {
+		v.Set("is_current_date", "true")
+	}
+	return v
+}
+
+// New method will create an exchange rate object and return a pointer to it
+func (er *ExchangeRate) New() Resource {
+	var obj = &ExchangeRate{}
+	return obj
+}
+
+// Endpoint method returns the endpoint of the resource, exchange rates
+// are nested under the currency so the path needs the currency id
+func (er *ExchangeRate) Endpoint() string {
+	return er.endpoint(er.CurrencyID)
+}
+
+func (er *ExchangeRate) endpoint(currencyID string) string {
+	return "/settings/currencies/" + currencyID + "/exchangerates"
+}
+
+// Create method will try to create an exchange rate for the currency
+func (er *ExchangeRate) Create(currencyID string, params *ExchangeRateParams, client *Client) (*ExchangeRate, error) {
+	return er.CreateWithContext(context.Background(), currencyID, params, client)
+}
+
+// CreateWithContext will try to create an exchange rate using the given context
+func (er *ExchangeRate) CreateWithContext(ctx context.Context, currencyID string, params *ExchangeRateParams, client *Client) (*ExchangeRate, error) {
+	var body, _ = json.Marshal(params)
+	resp, err := client.PostWithContext(ctx, er.endpoint(currencyID), string(body))
+
+	respData, err := SendResp(resp, err, er)
+	if err != nil {
+		return er, err
+	}
+	return &respData.ExchangeRate, err
+}
+
+// FindOne tries to find the exchange rate of the currency with given id
+func (er *ExchangeRate) FindOne(currencyID, id string, client *Client) (*ExchangeRate, error) {
+	return er.FindOneWithContext(context.Background(), currencyID, id, client)
+}
+
+// FindOneWithContext tries to find the exchange rate with given id using the given context
+func (er *ExchangeRate) FindOneWithContext(ctx context.Context, currencyID, id string, client *Client) (*ExchangeRate, error) {
+	resp, err := client.GetWithContext(ctx, er.endpoint(currencyID)+"/"+id)
+	respData, err := SendResp(resp, err, er)
+	if err != nil {
+		return er, err
+	}
+	return &respData.ExchangeRate, err
+}
+
+// FindAll will return the exchange rates of the currency matching the options
+func (er *ExchangeRate) FindAll(currencyID string, opts *ExchangeRateFindOptions, client *Client) ([]ExchangeRate, error) {
+	return er.FindAllWithContext(context.Background(), currencyID, opts, client)
+}
+
+// FindAllWithContext will return the exchange rates of the currency using the given context
+func (er *ExchangeRate) FindAllWithContext(ctx context.Context, currencyID string, opts *ExchangeRateFindOptions, client *Client) ([]ExchangeRate, error) {
+	var results []ExchangeRate
+	resp, err := client.GetWithContext(ctx, withQuery(er.endpoint(currencyID), opts))
+	respData, err := SendResp(resp, err, er)
+	if err != nil {
+		return results, err
+	}
+	for _, rate := range respData.ExchangeRates {
+		results = append(results, rate)
+	}
+	return results, nil
+}
+
+// Update method will try to update an exchange rate of the currency
+func (er *ExchangeRate) Update(currencyID, id string, params *ExchangeRateParams, client *Client) (*ExchangeRate, error) {
+	return er.UpdateWithContext(context.Background(), currencyID, id, params, client)
+}
+
+// UpdateWithContext will try to update an exchange rate using the given context
+func (er *ExchangeRate) UpdateWithContext(ctx context.Context, currencyID, id string, params *ExchangeRateParams, client *Client) (*ExchangeRate, error) {
+	var body, _ = json.Marshal(params)
+	resp, err := client.PutWithContext(ctx, er.endpoint(currencyID)+"/"+id, string(body))
+
+	respData, err := SendResp(resp, err, er)
+	if err != nil {
+		return er, err
+	}
+	return &respData.ExchangeRate, err
+}
+
+// Delete tries to delete the exchange rate of the currency with given id
+func (er *ExchangeRate) Delete(currencyID, id string, client *Client) error {
+	return er.DeleteWithContext(context.Background(), currencyID, id, client)
+}
+
+// DeleteWithContext tries to delete the exchange rate with given id using the given context
+func (er *ExchangeRate) DeleteWithContext(ctx context.Context, currencyID, id string, client *Client) error {
+	resp, err := client.DeleteWithContext(ctx, er.endpoint(currencyID)+"/"+id)
+	_, err = SendResp(resp, err, er)
+	return err
+}
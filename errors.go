@@ -0,0 +1,117 @@
+package zohobooks
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ErrCodeNotFound is the zohobooks error code for a resource which does not exist
+const ErrCodeNotFound = 1002
+
+// ErrCodeInvalidToken is the zohobooks error code for an invalid or expired token
+const ErrCodeInvalidToken = 14
+
+// ErrCodeNotAuthorized is the zohobooks error code for an operation the token
+// is not authorized to perform
+const ErrCodeNotAuthorized = 57
+
+// ErrNotFound is returned, usually wrapped, by the lookups done on the client
+// side when nothing matches
+var ErrNotFound = errors.New("zohobooks: not found")
+
+// notFoundError is a not found error with a more specific message, it
+// matches ErrNotFound with errors.Is
+type notFoundError struct {
+	msg string
+}
+
+func (e *notFoundError) Error() string {
+	return e.msg
+}
+
+func (e *notFoundError) Is(target error) bool {
+	return target == ErrNotFound
+}
+
+// ErrorDetail struct contains a nested error of the zohobooks response
+type ErrorDetail struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// APIError struct represents an error returned by zohobooks along with the
+// details of the request which caused it
+type APIError struct {
+	Code       int
+	Message    string
+	HTTPStatus int
+	Method     string
+	Endpoint   string
+	Errors     []ErrorDetail
+}
+
+func (e *APIError) Error() string {
+	var msg = fmt.Sprintf("zohobooks: %s %s: status %d", e.Method, e.Endpoint, e.HTTPStatus)
+	if e.Code > 0 {
+		msg += fmt.Sprintf(", code %d", e.Code)
+	}
+	if len(e.Message) > 0 {
+		msg += ": " + e.Message
+	}
+	return msg
+}
+
+func newAPIError(resp *http.Response, code int, message string, details []ErrorDetail) *APIError {
+	var apiErr = &APIError{
+		Code:       code,
+		Message:    message,
+		HTTPStatus: resp.StatusCode,
+		Errors:     details,
+	}
+	if resp.Request != nil {
+		apiErr.Method = resp.Request.Method
+		if resp.Request.URL != nil {
+			apiErr.Endpoint = resp.Request.URL.Path
+		}
+	}
+	return apiErr
+}
+
+// IsNotFound reports whether the error was caused by a missing resource
+func IsNotFound(err error) bool {
+	if errors.Is(err, ErrNotFound) {
+		return true
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.HTTPStatus == http.StatusNotFound || apiErr.Code == ErrCodeNotFound
+}
+
+// IsRateLimited reports whether the error was caused by zohobooks throttling
+// the requests
+func IsRateLimited(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.HTTPStatus == http.StatusTooManyRequests
+}
+
+// IsAuthError reports whether the error was caused by an invalid token or
+// missing permissions
+func IsAuthError(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	switch {
+	case apiErr.HTTPStatus == http.StatusUnauthorized, apiErr.HTTPStatus == http.StatusForbidden:
+		return true
+	case apiErr.Code == ErrCodeInvalidToken, apiErr.Code == ErrCodeNotAuthorized:
+		return true
+	}
+	return false
+}
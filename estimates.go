@@ -0,0 +1,274 @@
+package zohobooks
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+)
+
+// Estimate struct represents the information of an estimate (quote)
+type Estimate struct {
+	ID             string   `json:"estimate_id"`
+	EstimateNumber string   `json:"estimate_number"`
+	CustomerID     string   `json:"customer_id"`
+	CustomerName   string   `json:"customer_name"`
+	ContactPersons []string `json:"contact_persons"`
+	PlaceOfSupply  string   `json:"place_of_supply"`
+
+	// possible values ---> vat_registered,vat_not_registered,gcc_vat_not_registered,gcc_vat_registered,non_gcc,dz_vat_registered and dz_vat_not_registered.
+	TaxTreatment string `json:"tax_treatment"`
+	GstNO        string `json:"gst_no"`        // 15 digit
+	GstTreatment string `json:"gst_treatment"` // Allowed values are business_gst , business_none , overseas , consumer
+
+	Status         string     `json:"status"` // draft, sent, invoiced, accepted, declined or expired
+	Date           string     `json:"date"`
+	ExpiryDate     string     `json:"expiry_date"`
+	CurrencyCode   string     `json:"currency_code"`
+	CurrencyID     string     `json:"currency_id"`
+	ExchangeRate   float64    `json:"exchange_rate"`
+	Discount       float64    `json:"discount"`
+	IsInclusiveTax bool       `json:"is_inclusive_tax"`
+	RefNo          string     `json:"reference_number"`
+	LineItems      []LineItem `json:"line_items"`
+	Notes          string     `json:"notes"`
+	Terms          string     `json:"terms"`
+	BranchID       string     `json:"branch_id"`
+
+	SubTotal float64   `json:"sub_total"`
+	TaxTotal float64   `json:"tax_total"`
+	Total    float64   `json:"total"`
+	Taxes    []taxInfo `json:"taxes"`
+
+	CreatedTime      string `json:"created_time"`
+	LastModifiedTime string `json:"last_modified_time"`
+	EstimateURL      string `json:"estimate_url"`
+
+	BillingAddress  BillingAddress `json:"billing_address"`
+	ShippingAddress BillingAddress `json:"shipping_address"`
+}
+
+// EstimateParams struct represents the information to create an estimate
+type EstimateParams struct {
+	CustomerID     string   `json:"customer_id"`
+	ContactPersons []string `json:"contact_persons,omitempty"`
+	EstimateNumber string   `json:"estimate_number,omitempty"`
+	ReferenceNo    string   `json:"reference_number,omitempty"`
+	PlaceOfSupply  string   `json:"place_of_supply,omitempty"`
+
+	// possible values ---> vat_registered,vat_not_registered,gcc_vat_not_registered,gcc_vat_registered,non_gcc,dz_vat_registered and dz_vat_not_registered.
+	TaxTreatment string `json:"tax_treatment,omitempty"`
+	GstNO        string `json:"gst_no,omitempty"`        // 15 digit
+	GstTreatment string `json:"gst_treatment,omitempty"` // Allowed values are business_gst , business_none , overseas , consumer
+
+	Date           string     `json:"date,omitempty"`
+	ExpiryDate     string     `json:"expiry_date,omitempty"`
+	ExchangeRate   float64    `json:"exchange_rate,omitempty"`
+	IsInclusiveTax bool       `json:"is_inclusive_tax"`
+	Discount       float64    `json:"discount,omitempty"`
+	TaxID          string     `json:"tax_id,omitempty"`
+	LineItems      []LineItem `json:"line_items"`
+	Notes          string     `json:"notes,omitempty"`
+	Terms          string     `json:"terms,omitempty"`
+	BranchID       string     `json:"branch_id,omitempty"`
+}
+
+// EstimateFindOptions struct contains the filters used to list estimates
+type EstimateFindOptions struct {
+	CustomerID     string
+	EstimateNumber string
+	ReferenceNo    string
+	ListOptions
+}
+
+// Values encodes the options as query parameters
+func (o *EstimateFindOptions) Values() url.Values {
+	if o == nil {
+		return url.Values{}
+	}
+	var v = o.ListOptions.Values()
+	setString(v, "customer_id", o.CustomerID)
+	setString(v, "estimate_number", o.EstimateNumber)
+	setString(v, "reference_number", o.ReferenceNo)
+	return v
+}
+
+// New method will create an estimate object and return a pointer to it
+func (e *Estimate) New() Resource {
+	var obj = &Estimate{}
+	return obj
+}
+
+// Endpoint method returns the endpoint of the resource
+func (e *Estimate) Endpoint() string {
+	return "/estimates"
+}
+
+// Create method will try to create an estimate on zohobooks
+func (e *Estimate) Create(params *EstimateParams, client *Client) (*Estimate, error) {
+	return e.CreateWithContext(context.Background(), params, client)
+}
+
+// CreateWithContext will try to create an estimate using the given context
+func (e *Estimate) CreateWithContext(ctx context.Context, params *EstimateParams, client *Client) (*Estimate, error) {
+	var body, _ = json.Marshal(params)
+	resp, err := client.PostWithContext(ctx, e.Endpoint(), string(body))
+
+	respData, err := SendResp(resp, err, e)
+	if err != nil {
+		return e, err
+	}
+	return &respData.Estimate, err
+}
+
+// FindOne tries to find the estimate with given id
+func (e *Estimate) FindOne(id string, client *Client) (*Estimate, error) {
+	return e.FindOneWithContext(context.Background(), id, client)
+}
+
+// FindOneWithContext tries to find the estimate with given id using the given context
+func (e *Estimate) FindOneWithContext(ctx context.Context, id string, client *Client) (*Estimate, error) {
+	resp, err := client.GetWithContext(ctx, e.Endpoint()+"/"+id)
+	respData, err := SendResp(resp, err, e)
+	if err != nil {
+		return e, err
+	}
+	return &respData.Estimate, err
+}
+
+// FindAll tries to find the estimates with given options
+func (e *Estimate) FindAll(opts *EstimateFindOptions, client *Client) ([]Estimate, error) {
+	return e.FindAllWithContext(context.Background(), opts, client)
+}
+
+// FindAllWithContext tries to find the estimates with given options using the given context
+func (e *Estimate) FindAllWithContext(ctx context.Context, opts *EstimateFindOptions, client *Client) ([]Estimate, error) {
+	resp, err := client.GetWithContext(ctx, withQuery(e.Endpoint(), opts))
+	respData, err := SendResp(resp, err, e)
+
+	var results []Estimate
+	if err != nil {
+		return results, err
+	}
+	for _, est := range respData.Estimates {
+		results = append(results, est)
+	}
+	return results, err
+}
+
+// Iterator returns an iterator over all the pages of estimates with given options
+func (e *Estimate) Iterator(opts *EstimateFindOptions, client *Client) *Iterator[Estimate] {
+	return NewIterator(func(ctx context.Context, page, perPage int) ([]Estimate, *PageContext, error) {
+		resp, err := client.GetWithContext(ctx, withPage(e.Endpoint(), opts, page, perPage))
+		respData, err := SendResp(resp, err, e)
+		if err != nil {
+			return nil, nil, err
+		}
+		return respData.Estimates, &respData.PageContext, nil
+	})
+}
+
+// Update method will try to update an estimate on zohobooks
+func (e *Estimate) Update(id string, params *EstimateParams, client *Client) (*Estimate, error) {
+	return e.UpdateWithContext(context.Background(), id, params, client)
+}
+
+// UpdateWithContext will try to update an estimate using the given context
+func (e *Estimate) UpdateWithContext(ctx context.Context, id string, params *EstimateParams, client *Client) (*Estimate, error) {
+	var body, _ = json.Marshal(params)
+	resp, err := client.PutWithContext(ctx, e.Endpoint()+"/"+id, string(body))
+
+	respData, err := SendResp(resp, err, e)
+	if err != nil {
+		return e, err
+	}
+	return &respData.Estimate, err
+}
+
+// Delete tries to delete the estimate with given id
+func (e *Estimate) Delete(id string, client *Client) error {
+	return e.DeleteWithContext(context.Background(), id, client)
+}
+
+// DeleteWithContext tries to delete the estimate with given id using the given context
+func (e *Estimate) DeleteWithContext(ctx context.Context, id string, client *Client) error {
+	resp, err := client.DeleteWithContext(ctx, e.Endpoint()+"/"+id)
+	_, err = SendResp(resp, err, e)
+	return err
+}
+
+// MarkSent marks the estimate with given id as sent
+func (e *Estimate) MarkSent(id string, client *Client) error {
+	return e.MarkSentWithContext(context.Background(), id, client)
+}
+
+// MarkSentWithContext marks the estimate with given id as sent using the given context
+func (e *Estimate) MarkSentWithContext(ctx context.Context, id string, client *Client) error {
+	resp, err := client.postAction(ctx, e.Endpoint()+"/"+id+"/status/sent")
+	_, err = SendResp(resp, err, e)
+	return err
+}
+
+// MarkAccepted marks the estimate with given id as accepted by the customer
+func (e *Estimate) MarkAccepted(id string, client *Client) error {
+	return e.MarkAcceptedWithContext(context.Background(), id, client)
+}
+
+// MarkAcceptedWithContext marks the estimate with given id as accepted using the given context
+func (e *Estimate) MarkAcceptedWithContext(ctx context.Context, id string, client *Client) error {
+	resp, err := client.postAction(ctx, e.Endpoint()+"/"+id+"/status/accepted")
+	_, err = SendResp(resp, err, e)
+	return err
+}
+
+// MarkDeclined marks the estimate with given id as declined by the customer
+func (e *Estimate) MarkDeclined(id string, client *Client) error {
+	return e.MarkDeclinedWithContext(context.Background(), id, client)
+}
+
+// MarkDeclinedWithContext marks the estimate with given id as declined using the given context
+func (e *Estimate) MarkDeclinedWithContext(ctx context.Context, id string, client *Client) error {
+	resp, err := client.postAction(ctx, e.Endpoint()+"/"+id+"/status/declined")
+	_, err = SendResp(resp, err, e)
+	return err
+}
+
+// Email method will send the estimate to the customer
+func (e *Estimate) Email(id string, params *EmailParams, client *Client) error {
+	return e.EmailWithContext(context.Background(), id, params, client)
+}
+
+// EmailWithContext will send the estimate to the customer using the given context
+func (e *Estimate) EmailWithContext(ctx context.Context, id string, params *EmailParams, client *Client) error {
+	var body, _ = json.Marshal(params)
+	resp, err := client.PostWithContext(ctx, e.Endpoint()+"/"+id+"/email", string(body))
+
+	_, err = SendResp(resp, err, e)
+	return err
+}
+
+// DownloadPDF method will download the pdf to the given filepath
+func (e *Estimate) DownloadPDF(id, filepath string, client *Client) error {
+	return e.DownloadPDFWithContext(context.Background(), id, filepath, client)
+}
+
+// DownloadPDFWithContext will download the pdf to the given filepath using the given context
+func (e *Estimate) DownloadPDFWithContext(ctx context.Context, id, filepath string, client *Client) error {
+	return client.download(ctx, appendQuery(e.Endpoint()+"/pdf", url.Values{"estimate_ids": {id}}), filepath)
+}
+
+// ConvertToInvoice creates an invoice from the accepted estimate with given id
+func (e *Estimate) ConvertToInvoice(id string, client *Client) (*Invoice, error) {
+	return e.ConvertToInvoiceWithContext(context.Background(), id, client)
+}
+
+// ConvertToInvoiceWithContext creates an invoice from the accepted estimate
+// with given id using the given context
+func (e *Estimate) ConvertToInvoiceWithContext(ctx context.Context, id string, client *Client) (*Invoice, error) {
+	var inv = &Invoice{}
+	resp, err := client.postAction(ctx, appendQuery(inv.Endpoint()+"/fromestimate", url.Values{"estimate_id": {id}}))
+	respData, err := SendResp(resp, err, inv)
+	if err != nil {
+		return nil, err
+	}
+	return &respData.Invoice, err
+}
@@ -0,0 +1,256 @@
+package zohobooks
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+)
+
+// Expense struct represents the information of an expense
+type Expense struct {
+	ID               string  `json:"expense_id"`
+	AccountID        string  `json:"account_id"`
+	AccountName      string  `json:"account_name"`
+	PaidThroughID    string  `json:"paid_through_account_id"`
+	PaidThrough      string  `json:"paid_through_account_name"`
+	Date             string  `json:"date"`
+	Amount           float64 `json:"amount"`
+	Total            float64 `json:"total"`
+	SubTotal         float64 `json:"sub_total"`
+	TaxID            string  `json:"tax_id"`
+	TaxName          string  `json:"tax_name"`
+	TaxAmount        float64 `json:"tax_amount"`
+	IsInclusiveTax   bool    `json:"is_inclusive_tax"`
+	IsBillable       bool    `json:"is_billable"`
+	Status           string  `json:"status"` // unbilled, invoiced, reimbursed, non-billable or billable
+	RefNo            string  `json:"reference_number"`
+	Description      string  `json:"description"`
+	CustomerID       string  `json:"customer_id"`
+	CustomerName     string  `json:"customer_name"`
+	VendorID         string  `json:"vendor_id"`
+	VendorName       string  `json:"vendor_name"`
+	CurrencyID       string  `json:"currency_id"`
+	CurrencyCode     string  `json:"currency_code"`
+	ExchangeRate     float64 `json:"exchange_rate"`
+	ProjectID        string  `json:"project_id"`
+	HsnOrSac         string  `json:"hsn_or_sac"`
+	ReceiptName      string  `json:"receipt_name"`
+	InvoiceID        string  `json:"invoice_id"`
+	InvoiceNumber    string  `json:"invoice_number"`
+	EmployeeID       string  `json:"employee_id"`
+	EmployeeName     string  `json:"employee_name"`
+	GstNO            string  `json:"gst_no"`
+	SourceOfSupply   string  `json:"source_of_supply"`
+	DestOfSupply     string  `json:"destination_of_supply"`
+	ReverseCharge    bool    `json:"is_reverse_charge_applied"`
+	CreatedTime      string  `json:"created_time"`
+	LastModifiedTime string  `json:"last_modified_time"`
+
+	// mileage expenses
+	IsMileage    bool    `json:"is_mileage"`
+	MileageType  string  `json:"mileage_type"` // manual or odometer
+	MileageRate  float64 `json:"mileage_rate"`
+	MileageUnit  string  `json:"mileage_unit"` // km or mile
+	Distance     float64 `json:"distance"`
+	StartReading float64 `json:"start_reading"`
+	EndReading   float64 `json:"end_reading"`
+}
+
+// ExpenseParams struct represents the information to create an expense
+type ExpenseParams struct {
+	AccountID      string  `json:"account_id"`
+	PaidThroughID  string  `json:"paid_through_account_id"`
+	Date           string  `json:"date"`
+	Amount         float64 `json:"amount"`
+	TaxID          string  `json:"tax_id,omitempty"`
+	IsInclusiveTax bool    `json:"is_inclusive_tax"`
+	IsBillable     bool    `json:"is_billable"`
+	RefNo          string  `json:"reference_number,omitempty"`
+	Description    string  `json:"description,omitempty"`
+	CustomerID     string  `json:"customer_id,omitempty"`
+	VendorID       string  `json:"vendor_id,omitempty"`
+	CurrencyID     string  `json:"currency_id,omitempty"`
+	ExchangeRate   float64 `json:"exchange_rate,omitempty"`
+	ProjectID      string  `json:"project_id,omitempty"`
+	HsnOrSac       string  `json:"hsn_or_sac,omitempty"`
+	EmployeeID     string  `json:"employee_id,omitempty"`
+	GstNO          string  `json:"gst_no,omitempty"`
+	SourceOfSupply string  `json:"source_of_supply,omitempty"`
+	DestOfSupply   string  `json:"destination_of_supply,omitempty"`
+	ReverseCharge  bool    `json:"is_reverse_charge_applied,omitempty"`
+
+	// mileage expenses
+	IsMileage    bool    `json:"is_mileage,omitempty"`
+	MileageType  string  `json:"mileage_type,omitempty"`
+	MileageRate  float64 `json:"mileage_rate,omitempty"`
+	MileageUnit  string  `json:"mileage_unit,omitempty"`
+	Distance     float64 `json:"distance,omitempty"`
+	StartReading float64 `json:"start_reading,omitempty"`
+	EndReading   float64 `json:"end_reading,omitempty"`
+}
+
+// ExpenseFindOptions struct contains the filters used to list expenses, the
+// date range is set through DateStart and DateEnd of ListOptions
+type ExpenseFindOptions struct {
+	Date        string
+	Status      string
+	AccountName string
+	CustomerID  string
+	VendorID    string
+	ListOptions
+}
+
+// Values encodes the options as query parameters
+func (o *ExpenseFindOptions) Values() url.Values {
+	if o == nil {
+		return url.Values{}
+	}
+	var v = o.ListOptions.Values()
+	setString(v, "date", o.Date)
+	setString(v, "status", o.Status)
+	setString(v, "account_name", o.AccountName)
+	setString(v, "customer_id", o.CustomerID)
+	setString(v, "vendor_id", o.VendorID)
+	return v
+}
+
+// New method will create an expense object and return a pointer to it
+func (e *Expense) New() Resource {
+	var obj = &Expense{}
+	return obj
+}
+
+// Endpoint method returns the endpoint of the resource
+func (e *Expense) Endpoint() string {
+	return "/expenses"
+}
+
+// Create method will try to create an expense on zohobooks
+func (e *Expense) Create(params *ExpenseParams, client *Client) (*Expense, error) {
+	return e.CreateWithContext(context.Background(), params, client)
+}
+
+// CreateWithContext will try to create an expense using the given context
+func (e *Expense) CreateWithContext(ctx context.Context, params *ExpenseParams, client *Client) (*Expense, error) {
+	var body, _ = json.Marshal(params)
+	resp, err := client.PostWithContext(ctx, e.Endpoint(), string(body))
+
+	respData, err := SendResp(resp, err, e)
+	if err != nil {
+		return e, err
+	}
+	return &respData.Expense, err
+}
+
+// FindOne tries to find the expense with given id
+func (e *Expense) FindOne(id string, client *Client) (*Expense, error) {
+	return e.FindOneWithContext(context.Background(), id, client)
+}
+
+// FindOneWithContext tries to find the expense with given id using the given context
+func (e *Expense) FindOneWithContext(ctx context.Context, id string, client *Client) (*Expense, error) {
+	resp, err := client.GetWithContext(ctx, e.Endpoint()+"/"+id)
+	respData, err := SendResp(resp, err, e)
+	if err != nil {
+		return e, err
+	}
+	return &respData.Expense, err
+}
+
+// FindAll tries to find the expenses with given options
+func (e *Expense) FindAll(opts *ExpenseFindOptions, client *Client) ([]Expense, error) {
+	return e.FindAllWithContext(context.Background(), opts, client)
+}
+
+// FindAllWithContext tries to find the expenses with given options using the given context
+func (e *Expense) FindAllWithContext(ctx context.Context, opts *ExpenseFindOptions, client *Client) ([]Expense, error) {
+	resp, err := client.GetWithContext(ctx, withQuery(e.Endpoint(), opts))
+	respData, err := SendResp(resp, err, e)
+
+	var results []Expense
+	if err != nil {
+		return results, err
+	}
+	for _, exp := range respData.Expenses {
+		results = append(results, exp)
+	}
+	return results, err
+}
+
+// Iterator returns an iterator over all the pages of expenses with given options
+func (e *Expense) Iterator(opts *ExpenseFindOptions, client *Client) *Iterator[Expense] {
+	return NewIterator(func(ctx context.Context, page, perPage int) ([]Expense, *PageContext, error) {
+		resp, err := client.GetWithContext(ctx, withPage(e.Endpoint(), opts, page, perPage))
+		respData, err := SendResp(resp, err, e)
+		if err != nil {
+			return nil, nil, err
+		}
+		return respData.Expenses, &respData.PageContext, nil
+	})
+}
+
+// Update method will try to update an expense on zohobooks
+func (e *Expense) Update(id string, params *ExpenseParams, client *Client) (*Expense, error) {
+	return e.UpdateWithContext(context.Background(), id, params, client)
+}
+
+// UpdateWithContext will try to update an expense using the given context
+func (e *Expense) UpdateWithContext(ctx context.Context, id string, params *ExpenseParams, client *Client) (*Expense, error) {
+	var body, _ = json.Marshal(params)
+	resp, err := client.PutWithContext(ctx, e.Endpoint()+"/"+id, string(body))
+
+	respData, err := SendResp(resp, err, e)
+	if err != nil {
+		return e, err
+	}
+	return &respData.Expense, err
+}
+
+// Delete tries to delete the expense with given id
+func (e *Expense) Delete(id string, client *Client) error {
+	return e.DeleteWithContext(context.Background(), id, client)
+}
+
+// DeleteWithContext tries to delete the expense with given id using the given context
+func (e *Expense) DeleteWithContext(ctx context.Context, id string, client *Client) error {
+	resp, err := client.DeleteWithContext(ctx, e.Endpoint()+"/"+id)
+	_, err = SendResp(resp, err, e)
+	return err
+}
+
+// UploadReceipt attaches the receipt at filepath to the expense with given id
+func (e *Expense) UploadReceipt(id, filepath string, client *Client) error {
+	return e.UploadReceiptWithContext(context.Background(), id, filepath, client)
+}
+
+// UploadReceiptWithContext attaches the receipt at filepath to the expense
+// with given id using the given context
+func (e *Expense) UploadReceiptWithContext(ctx context.Context, id, filepath string, client *Client) error {
+	resp, err := client.upload(ctx, e.Endpoint()+"/"+id+"/receipt", "receipt", filepath)
+	_, err = SendResp(resp, err, e)
+	return err
+}
+
+// DownloadReceipt will download the receipt of the expense to the given filepath
+func (e *Expense) DownloadReceipt(id, filepath string, client *Client) error {
+	return e.DownloadReceiptWithContext(context.Background(), id, filepath, client)
+}
+
+// DownloadReceiptWithContext will download the receipt of the expense to the
+// given filepath using the given context
+func (e *Expense) DownloadReceiptWithContext(ctx context.Context, id, filepath string, client *Client) error {
+	return client.download(ctx, e.Endpoint()+"/"+id+"/receipt", filepath)
+}
+
+// DeleteReceipt removes the receipt of the expense with given id
+func (e *Expense) DeleteReceipt(id string, client *Client) error {
+	return e.DeleteReceiptWithContext(context.Background(), id, client)
+}
+
+// DeleteReceiptWithContext removes the receipt of the expense with given id
+// using the given context
+func (e *Expense) DeleteReceiptWithContext(ctx context.Context, id string, client *Client) error {
+	resp, err := client.DeleteWithContext(ctx, e.Endpoint()+"/"+id+"/receipt")
+	_, err = SendResp(resp, err, e)
+	return err
+}
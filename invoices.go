@@ -1,13 +1,10 @@
 package zohobooks
 
 import (
-	"bytes"
+	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
-	"io"
-	"os"
-	"strings"
+	"net/url"
 	"time"
 )
 
@@ -72,15 +69,16 @@ type Invoice struct {
 	Total    float64   `json:"total"`
 	Taxes    []taxInfo `json:"taxes"`
 
-	PaymentReminder   bool    `json:"payment_reminder_enabled"`
-	PaymentMade       float64 `json:"payment_made"`
-	CreditsApplied    float64 `json:"credits_applied"`
-	TaxAmountWithheld float64 `json:"tax_amount_withheld"`
-	Balance           float64 `json:"balance"`
-	WriteOffAmount    float64 `json:"write_off_amount"`
-	CreatedTime       string  `json:"created_time"`
-	LastModifiedTime  string  `json:"last_modified_time"`
-	InvoiceURL        string  `json:"invoice_url"`
+	PaymentReminder   bool         `json:"payment_reminder_enabled"`
+	PaymentMade       float64      `json:"payment_made"`
+	CreditsApplied    float64      `json:"credits_applied"`
+	Credits           []CreditInfo `json:"credits"`
+	TaxAmountWithheld float64      `json:"tax_amount_withheld"`
+	Balance           float64      `json:"balance"`
+	WriteOffAmount    float64      `json:"write_off_amount"`
+	CreatedTime       string       `json:"created_time"`
+	LastModifiedTime  string       `json:"last_modified_time"`
+	InvoiceURL        string       `json:"invoice_url"`
 
 	Country     string      `json:"country"`
 	EInvDetails EInvDetails `json:"einvoice_details"`
@@ -100,6 +98,7 @@ type EInvDetails struct {
 // LineItem struct contains info about the line items of the invoice
 type LineItem struct {
 	ItemID      string  `json:"item_id,omitempty"`
+	AccountID   string  `json:"account_id,omitempty"`
 	ProjectID   string  `json:"project_id,omitempty"`
 	ProductType string  `json:"product_type,omitempty"`
 	Name        string  `json:"name"`
@@ -114,6 +113,9 @@ type LineItem struct {
 	TaxPercent  float64 `json:"tax_percentage,omitempty"`
 
 	LineItemTaxes []LineItemTaxes `json:"line_item_taxes,omitempty"`
+
+	// PurchaseOrderItemID links a bill line item to the purchase order line item it is billing
+	PurchaseOrderItemID string `json:"purchaseorder_item_id,omitempty"`
 }
 
 // InvoiceParams struct represents the information to create a invoice
@@ -146,7 +148,11 @@ type InvoiceParams struct {
 }
 
 // InvoiceEmailParams struct contains the parameters to be used while sending invoices
-type InvoiceEmailParams struct {
+type InvoiceEmailParams = EmailParams
+
+// EmailParams struct contains the parameters to be used while emailing a
+// transaction such as an invoice or an estimate
+type EmailParams struct {
 	SendFromOrgEmail bool     `json:"send_from_org_email_id"`
 	ToMailIDs        []string `json:"to_mail_ids"`
 	CCMailIDs        []string `json:"cc_mail_ids,omitempty"`
@@ -176,8 +182,13 @@ func (i *Invoice) Endpoint() string {
 
 // Create method will try to create a invoice on razorpay
 func (i *Invoice) Create(params *InvoiceParams, client *Client) (*Invoice, error) {
+	return i.CreateWithContext(context.Background(), params, client)
+}
+
+// CreateWithContext will try to create a invoice using the given context
+func (i *Invoice) CreateWithContext(ctx context.Context, params *InvoiceParams, client *Client) (*Invoice, error) {
 	var body, _ = json.Marshal(params)
-	resp, err := client.Post(i.Endpoint(), string(body))
+	resp, err := client.PostWithContext(ctx, i.Endpoint(), string(body))
 
 	respData, err := SendResp(resp, err, i)
 	if err != nil {
@@ -188,8 +199,13 @@ func (i *Invoice) Create(params *InvoiceParams, client *Client) (*Invoice, error
 
 // Update method will try to update a invoice on razorpay
 func (i *Invoice) Update(id string, params *InvoiceParams, client *Client) (*Invoice, error) {
+	return i.UpdateWithContext(context.Background(), id, params, client)
+}
+
+// UpdateWithContext will try to update a invoice using the given context
+func (i *Invoice) UpdateWithContext(ctx context.Context, id string, params *InvoiceParams, client *Client) (*Invoice, error) {
 	var body, _ = json.Marshal(params)
-	resp, err := client.Put(i.Endpoint()+"/"+id, string(body))
+	resp, err := client.PutWithContext(ctx, i.Endpoint()+"/"+id, string(body))
 
 	respData, err := SendResp(resp, err, i)
 	if err != nil {
@@ -200,9 +216,14 @@ func (i *Invoice) Update(id string, params *InvoiceParams, client *Client) (*Inv
 
 // update invoice billing address
 func (i *Invoice) UpdateInvBillingAddress(id string, billingAddress *BAddrInvoiceParams, client *Client) (*Invoice, error) {
+	return i.UpdateInvBillingAddressWithContext(context.Background(), id, billingAddress, client)
+}
+
+// UpdateInvBillingAddressWithContext updates the invoice billing address using the given context
+func (i *Invoice) UpdateInvBillingAddressWithContext(ctx context.Context, id string, billingAddress *BAddrInvoiceParams, client *Client) (*Invoice, error) {
 	url := fmt.Sprintf("%s/%s/address/billing", i.Endpoint(), id)
 	var body, _ = json.Marshal(billingAddress)
-	resp, err := client.Put(url, string(body))
+	resp, err := client.PutWithContext(ctx, url, string(body))
 	respData, err := SendResp(resp, err, i)
 	if err != nil {
 		return i, err
@@ -212,20 +233,29 @@ func (i *Invoice) UpdateInvBillingAddress(id string, billingAddress *BAddrInvoic
 
 // push Invoice to IRP portal and returns the Invoice with IRP Ack Num and Ref Num
 func (i *Invoice) PushInvoiceToIRP(id string, client *Client) (*Invoice, error) {
+	return i.PushInvoiceToIRPWithContext(context.Background(), id, client)
+}
+
+// PushInvoiceToIRPWithContext pushes the invoice to IRP portal using the given context
+func (i *Invoice) PushInvoiceToIRPWithContext(ctx context.Context, id string, client *Client) (*Invoice, error) {
 	url := fmt.Sprintf("%s/%s/einvoice/push", i.Endpoint(), id)
 	headers := map[string]string{}
 	var emptyBody []byte
 
-	resp, err := client.makeRequest("POST", url, bytes.NewBuffer(emptyBody), headers)
+	resp, err := client.makeRequest(ctx, "POST", url, emptyBody, headers)
 	respData, err := SendResp(resp, err, i)
 	if err != nil {
 		return nil, err
 	}
-	if len(respData.Data.Errors) > 0 {
-		return nil, errors.New(strings.ToLower(respData.Data.Errors[0].Message))
+	if details := respData.Data.Errors; len(details) > 0 {
+		return nil, newAPIError(resp, details[0].Code, details[0].Message, details)
+	}
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-time.After(1 * time.Second):
 	}
-	time.Sleep(1 * time.Second)
-	pushedInvoice, err := i.FindOne(id, client)
+	pushedInvoice, err := i.FindOneWithContext(ctx, id, client)
 	if err != nil {
 		return nil, err
 	}
@@ -234,7 +264,12 @@ func (i *Invoice) PushInvoiceToIRP(id string, client *Client) (*Invoice, error)
 
 // FindOne tries to find the invoice with given id
 func (i *Invoice) FindOne(id string, client *Client) (*Invoice, error) {
-	resp, err := client.Get(i.Endpoint() + "/" + id)
+	return i.FindOneWithContext(context.Background(), id, client)
+}
+
+// FindOneWithContext tries to find the invoice with given id using the given context
+func (i *Invoice) FindOneWithContext(ctx context.Context, id string, client *Client) (*Invoice, error) {
+	resp, err := client.GetWithContext(ctx, i.Endpoint()+"/"+id)
 	respData, err := SendResp(resp, err, i)
 	if err != nil {
 		return i, err
@@ -244,32 +279,24 @@ func (i *Invoice) FindOne(id string, client *Client) (*Invoice, error) {
 
 // Email method will send the invoice to the customer
 func (i *Invoice) Email(id string, params *InvoiceEmailParams, client *Client) {
+	i.EmailWithContext(context.Background(), id, params, client)
+}
+
+// EmailWithContext will send the invoice to the customer using the given context
+func (i *Invoice) EmailWithContext(ctx context.Context, id string, params *InvoiceEmailParams, client *Client) error {
 	var body, _ = json.Marshal(params)
-	resp, err := client.Post(i.Endpoint()+"/"+id+"/email?send_attachment=true", string(body))
+	resp, err := client.PostWithContext(ctx, appendQuery(i.Endpoint()+"/"+id+"/email", url.Values{"send_attachment": {"true"}}), string(body))
 
-	SendResp(resp, err, i)
+	_, err = SendResp(resp, err, i)
+	return err
 }
 
 // DownloadPDF method will download the pdf to the given filepath
 func (i *Invoice) DownloadPDF(id, filepath string, client *Client) error {
-	// Create the file
-	f, err := os.Create(filepath)
-	if err != nil {
-		return err
-	}
-	defer f.Close()
-
-	// Get the data
-	resp, err := client.Get(i.Endpoint() + "/pdf?invoice_ids=" + id)
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
+	return i.DownloadPDFWithContext(context.Background(), id, filepath, client)
+}
 
-	// Writer the body to file
-	_, err = io.Copy(f, resp.Body)
-	if err != nil {
-		return err
-	}
-	return nil
+// DownloadPDFWithContext will download the pdf to the given filepath using the given context
+func (i *Invoice) DownloadPDFWithContext(ctx context.Context, id, filepath string, client *Client) error {
+	return client.download(ctx, appendQuery(i.Endpoint()+"/pdf", url.Values{"invoice_ids": {id}}), filepath)
 }
@@ -0,0 +1,229 @@
+package zohobooks
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+)
+
+// ItemTaxPreference struct contains the tax applied to an item for intra or
+// inter state supplies
+type ItemTaxPreference struct {
+	TaxID            string  `json:"tax_id"`
+	TaxName          string  `json:"tax_name,omitempty"`
+	TaxPercentage    float64 `json:"tax_percentage,omitempty"`
+	TaxSpecification string  `json:"tax_specification"` // Allowed values are intra and inter
+}
+
+// Item struct represents the information of a product or service
+type Item struct {
+	ID          string  `json:"item_id"`
+	Name        string  `json:"name"`
+	Status      string  `json:"status"`
+	Description string  `json:"description"`
+	Rate        float64 `json:"rate"`
+	Unit        string  `json:"unit"`
+	SKU         string  `json:"sku"`
+	ProductType string  `json:"product_type"` // Allowed values are goods and service
+	ItemType    string  `json:"item_type"`    // Allowed values are sales, purchases, sales_and_purchases and inventory
+	HsnOrSac    string  `json:"hsn_or_sac"`
+
+	TaxID          string              `json:"tax_id"`
+	TaxName        string              `json:"tax_name"`
+	TaxPercentage  float64             `json:"tax_percentage"`
+	IsTaxable      bool                `json:"is_taxable"`
+	TaxExemptionID string              `json:"tax_exemption_id"`
+	TaxPreferences []ItemTaxPreference `json:"item_tax_preferences"`
+
+	AccountID           string  `json:"account_id"`
+	AccountName         string  `json:"account_name"`
+	PurchaseRate        float64 `json:"purchase_rate"`
+	PurchaseDescription string  `json:"purchase_description"`
+	PurchaseAccountID   string  `json:"purchase_account_id"`
+	InventoryAccountID  string  `json:"inventory_account_id"`
+
+	ReorderLevel         float64 `json:"reorder_level"`
+	StockOnHand          float64 `json:"stock_on_hand"`
+	AvailableStock       float64 `json:"available_stock"`
+	ActualAvailableStock float64 `json:"actual_available_stock"`
+
+	CreatedTime      string `json:"created_time"`
+	LastModifiedTime string `json:"last_modified_time"`
+}
+
+// ItemParams struct represents the information to create an item
+type ItemParams struct {
+	Name        string  `json:"name"`
+	Description string  `json:"description,omitempty"`
+	Rate        float64 `json:"rate"`
+	Unit        string  `json:"unit,omitempty"`
+	SKU         string  `json:"sku,omitempty"`
+	ProductType string  `json:"product_type,omitempty"`
+	ItemType    string  `json:"item_type,omitempty"`
+	HsnOrSac    string  `json:"hsn_or_sac,omitempty"`
+
+	TaxID          string              `json:"tax_id,omitempty"`
+	IsTaxable      *bool               `json:"is_taxable,omitempty"`
+	TaxExemptionID string              `json:"tax_exemption_id,omitempty"`
+	TaxPreferences []ItemTaxPreference `json:"item_tax_preferences,omitempty"`
+
+	AccountID           string  `json:"account_id,omitempty"`
+	PurchaseRate        float64 `json:"purchase_rate,omitempty"`
+	PurchaseDescription string  `json:"purchase_description,omitempty"`
+	PurchaseAccountID   string  `json:"purchase_account_id,omitempty"`
+	InventoryAccountID  string  `json:"inventory_account_id,omitempty"`
+
+	ReorderLevel     float64 `json:"reorder_level,omitempty"`
+	InitialStock     float64 `json:"initial_stock,omitempty"`
+	InitialStockRate float64 `json:"initial_stock_rate,omitempty"`
+}
+
+// ItemFindOptions struct contains the filters used to list items
+type ItemFindOptions struct {
+	Name                string
+	NameContains        string
+	DescriptionContains string
+	TaxID               string
+	ListOptions
+}
+
+// Values encodes the options as query parameters
+func (o *ItemFindOptions) Values() url.Values {
+	if o == nil {
+		return url.Values{}
+	}
+	var v = o.ListOptions.Values()
+	setString(v, "name", o.Name)
+	setString(v, "name_contains", o.NameContains)
+	setString(v, "description_contains", o.DescriptionContains)
+	setString(v, "tax_id", o.TaxID)
+	return v
+}
+
+// New method will create an item object and return a pointer to it
+func (it *Item) New() Resource {
+	var obj = &Item{}
+	return obj
+}
+
+// Endpoint method returns the endpoint of the resource
+func (it *Item) Endpoint() string {
+	return "/items"
+}
+
+// Create method will try to create an item on zohobooks
+func (it *Item) Create(params *ItemParams, client *Client) (*Item, error) {
+	return it.CreateWithContext(context.Background(), params, client)
+}
+
+// CreateWithContext will try to create an item using the given context
+func (it *Item) CreateWithContext(ctx context.Context, params *ItemParams, client *Client) (*Item, error) {
+	var body, _ = json.Marshal(params)
+	resp, err := client.PostWithContext(ctx, it.Endpoint(), string(body))
+
+	respData, err := SendResp(resp, err, it)
+	if err != nil {
+		return it, err
+	}
+	return &respData.Item, err
+}
+
+// FindOne tries to find the item with given id
+func (it *Item) FindOne(id string, client *Client) (*Item, error) {
+	return it.FindOneWithContext(context.Background(), id, client)
+}
+
+// FindOneWithContext tries to find the item with given id using the given context
+func (it *Item) FindOneWithContext(ctx context.Context, id string, client *Client) (*Item, error) {
+	resp, err := client.GetWithContext(ctx, it.Endpoint()+"/"+id)
+	respData, err := SendResp(resp, err, it)
+	if err != nil {
+		return it, err
+	}
+	return &respData.Item, err
+}
+
+// FindAll tries to find the items with given options
+func (it *Item) FindAll(opts *ItemFindOptions, client *Client) ([]Item, error) {
+	return it.FindAllWithContext(context.Background(), opts, client)
+}
+
+// FindAllWithContext tries to find the items with given options using the given context
+func (it *Item) FindAllWithContext(ctx context.Context, opts *ItemFindOptions, client *Client) ([]Item, error) {
+	resp, err := client.GetWithContext(ctx, withQuery(it.Endpoint(), opts))
+	respData, err := SendResp(resp, err, it)
+
+	var results []Item
+	if err != nil {
+		return results, err
+	}
+	for _, item := range respData.Items {
+		results = append(results, item)
+	}
+	return results, err
+}
+
+// Iterator returns an iterator over all the pages of items with given options
+func (it *Item) Iterator(opts *ItemFindOptions, client *Client) *Iterator[Item] {
+	return NewIterator(func(ctx context.Context, page, perPage int) ([]Item, *PageContext, error) {
+		resp, err := client.GetWithContext(ctx, withPage(it.Endpoint(), opts, page, perPage))
+		respData, err := SendResp(resp, err, it)
+		if err != nil {
+			return nil, nil, err
+		}
+		return respData.Items, &respData.PageContext, nil
+	})
+}
+
+// Update method will try to update an item on zohobooks
+func (it *Item) Update(id string, params *ItemParams, client *Client) (*Item, error) {
+	return it.UpdateWithContext(context.Background(), id, params, client)
+}
+
+// UpdateWithContext will try to update an item using the given context
+func (it *Item) UpdateWithContext(ctx context.Context, id string, params *ItemParams, client *Client) (*Item, error) {
+	var body, _ = json.Marshal(params)
+	resp, err := client.PutWithContext(ctx, it.Endpoint()+"/"+id, string(body))
+
+	respData, err := SendResp(resp, err, it)
+	if err != nil {
+		return it, err
+	}
+	return &respData.Item, err
+}
+
+// Delete tries to delete the item with given id
+func (it *Item) Delete(id string, client *Client) error {
+	return it.DeleteWithContext(context.Background(), id, client)
+}
+
+// DeleteWithContext tries to delete the item with given id using the given context
+func (it *Item) DeleteWithContext(ctx context.Context, id string, client *Client) error {
+	resp, err := client.DeleteWithContext(ctx, it.Endpoint()+"/"+id)
+	_, err = SendResp(resp, err, it)
+	return err
+}
+
+// MarkActive marks the item with given id as active
+func (it *Item) MarkActive(id string, client *Client) error {
+	return it.MarkActiveWithContext(context.Background(), id, client)
+}
+
+// MarkActiveWithContext marks the item with given id as active using the given context
+func (it *Item) MarkActiveWithContext(ctx context.Context, id string, client *Client) error {
+	resp, err := client.postAction(ctx, it.Endpoint()+"/"+id+"/active")
+	_, err = SendResp(resp, err, it)
+	return err
+}
+
+// MarkInactive marks the item with given id as inactive
+func (it *Item) MarkInactive(id string, client *Client) error {
+	return it.MarkInactiveWithContext(context.Background(), id, client)
+}
+
+// MarkInactiveWithContext marks the item with given id as inactive using the given context
+func (it *Item) MarkInactiveWithContext(ctx context.Context, id string, client *Client) error {
+	resp, err := client.postAction(ctx, it.Endpoint()+"/"+id+"/inactive")
+	_, err = SendResp(resp, err, it)
+	return err
+}
@@ -0,0 +1,227 @@
+package zohobooks
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+)
+
+// JournalLineItem struct contains a debit or credit entry of a journal
+type JournalLineItem struct {
+	LineID        string  `json:"line_id,omitempty"`
+	AccountID     string  `json:"account_id"`
+	AccountName   string  `json:"account_name,omitempty"`
+	Description   string  `json:"description,omitempty"`
+	DebitOrCredit string  `json:"debit_or_credit"` // Allowed values are debit and credit
+	Amount        float64 `json:"amount"`
+	TaxID         string  `json:"tax_id,omitempty"`
+	CustomerID    string  `json:"customer_id,omitempty"`
+	ProjectID     string  `json:"project_id,omitempty"`
+}
+
+// Journal struct represents the information of a manual journal
+type Journal struct {
+	ID            string            `json:"journal_id"`
+	EntryNumber   string            `json:"entry_number"`
+	RefNo         string            `json:"reference_number"`
+	Notes         string            `json:"notes"`
+	Date          string            `json:"journal_date"`
+	JournalType   string            `json:"journal_type"` // both or cash
+	Status        string            `json:"status"`       // draft or published
+	CurrencyID    string            `json:"currency_id"`
+	CurrencyCode  string            `json:"currency_code"`
+	ExchangeRate  float64           `json:"exchange_rate"`
+	Total         float64           `json:"total"`
+	LineItems     []JournalLineItem `json:"line_items"`
+	BranchID      string            `json:"branch_id"`
+	CreatedTime   string            `json:"created_time"`
+	HasAttachment bool              `json:"has_attachment"`
+
+	LastModifiedTime string `json:"last_modified_time"`
+}
+
+// JournalParams struct represents the information to create a journal
+type JournalParams struct {
+	Date         string            `json:"journal_date"`
+	RefNo        string            `json:"reference_number,omitempty"`
+	Notes        string            `json:"notes,omitempty"`
+	JournalType  string            `json:"journal_type,omitempty"`
+	Status       string            `json:"status,omitempty"`
+	CurrencyID   string            `json:"currency_id,omitempty"`
+	ExchangeRate float64           `json:"exchange_rate,omitempty"`
+	LineItems    []JournalLineItem `json:"line_items"`
+	BranchID     string            `json:"branch_id,omitempty"`
+}
+
+// JournalFindOptions struct contains the filters used to list journals
+type JournalFindOptions struct {
+	EntryNumber string
+	ReferenceNo string
+	Date        string
+	ListOptions
+}
+
+// Values encodes the options as query parameters
+func (o *JournalFindOptions) Values() url.Values {
+	if o == nil {
+		return url.Values{}
+	}
+	var v = o.ListOptions.Values()
+	setString(v, "entry_number", o.EntryNumber)
+	setString(v, "reference_number", o.ReferenceNo)
+	setString(v, "date", o.Date)
+	return v
+}
+
+// New method will create a journal object and return a pointer to it
+func (j *Journal) New() Resource {
+	var obj = &Journal{}
+	return obj
+}
+
+// Endpoint method returns the endpoint of the resource
+func (j *Journal) Endpoint() string {
+	return "/journals"
+}
+
+// Create method will try to create a journal on zohobooks
+func (j *Journal) Create(params *JournalParams, client *Client) (*Journal, error) {
+	return j.CreateWithContext(context.Background(), params, client)
+}
+
+// CreateWithContext will try to create a journal using the given context
+func (j *Journal) CreateWithContext(ctx context.Context, params *JournalParams, client *Client) (*Journal, error) {
+	var body, _ = json.Marshal(params)
+	resp, err := client.PostWithContext(ctx, j.Endpoint(), string(body))
+
+	respData, err := SendResp(resp, err, j)
+	if err != nil {
+		return j, err
+	}
+	return &respData.Journal, err
+}
+
+// FindOne tries to find the journal with given id
+func (j *Journal) FindOne(id string, client *Client) (*Journal, error) {
+	return j.FindOneWithContext(context.Background(), id, client)
+}
+
+// FindOneWithContext tries to find the journal with given id using the given context
+func (j *Journal) FindOneWithContext(ctx context.Context, id string, client *Client) (*Journal, error) {
+	resp, err := client.GetWithContext(ctx, j.Endpoint()+"/"+id)
+	respData, err := SendResp(resp, err, j)
+	if err != nil {
+		return j, err
+	}
+	return &respData.Journal, err
+}
+
+// FindAll tries to find the journals with given options
+func (j *Journal) FindAll(opts *JournalFindOptions, client *Client) ([]Journal, error) {
+	return j.FindAllWithContext(context.Background(), opts, client)
+}
+
+// FindAllWithContext tries to find the journals with given options using the given context
+func (j *Journal) FindAllWithContext(ctx context.Context, opts *JournalFindOptions, client *Client) ([]Journal, error) {
+	resp, err := client.GetWithContext(ctx, withQuery(j.Endpoint(), opts))
+	respData, err := SendResp(resp, err, j)
+
+	var results []Journal
+	if err != nil {
+		return results, err
+	}
+	for _, jrnl := range respData.Journals {
+		results = append(results, jrnl)
+	}
+	return results, err
+}
+
+// Iterator returns an iterator over all the pages of journals with given options
+func (j *Journal) Iterator(opts *JournalFindOptions, client *Client) *Iterator[Journal] {
+	return NewIterator(func(ctx context.Context, page, perPage int) ([]Journal, *PageContext, error) {
+		resp, err := client.GetWithContext(ctx, withPage(j.Endpoint(), opts, page, perPage))
+		respData, err := SendResp(resp, err, j)
+		if err != nil {
+			return nil, nil, err
+		}
+		return respData.Journals, &respData.PageContext, nil
+	})
+}
+
+// Update method will try to update a journal on zohobooks
+func (j *Journal) Update(id string, params *JournalParams, client *Client) (*Journal, error) {
+	return j.UpdateWithContext(context.Background(), id, params, client)
+}
+
+// UpdateWithContext will try to update a journal using the given context
+func (j *Journal) UpdateWithContext(ctx context.Context, id string, params *JournalParams, client *Client) (*Journal, error) {
+	var body, _ = json.Marshal(params)
+	resp, err := client.PutWithContext(ctx, j.Endpoint()+"/"+id, string(body))
+
+	respData, err := SendResp(resp, err, j)
+	if err != nil {
+		return j, err
+	}
+	return &respData.Journal, err
+}
+
+// Delete tries to delete the journal with given id
+func (j *Journal) Delete(id string, client *Client) error {
+	return j.DeleteWithContext(context.Background(), id, client)
+}
+
+// DeleteWithContext tries to delete the journal with given id using the given context
+func (j *Journal) DeleteWithContext(ctx context.Context, id string, client *Client) error {
+	resp, err := client.DeleteWithContext(ctx, j.Endpoint()+"/"+id)
+	_, err = SendResp(resp, err, j)
+	return err
+}
+
+// Publish marks the draft journal with given id as published
+func (j *Journal) Publish(id string, client *Client) error {
+	return j.PublishWithContext(context.Background(), id, client)
+}
+
+// PublishWithContext marks the draft journal with given id as published using the given context
+func (j *Journal) PublishWithContext(ctx context.Context, id string, client *Client) error {
+	resp, err := client.postAction(ctx, j.Endpoint()+"/"+id+"/status/publish")
+	_, err = SendResp(resp, err, j)
+	return err
+}
+
+// UploadAttachment attaches the file at filepath to the journal with given id
+func (j *Journal) UploadAttachment(id, filepath string, client *Client) error {
+	return j.UploadAttachmentWithContext(context.Background(), id, filepath, client)
+}
+
+// UploadAttachmentWithContext attaches the file at filepath to the journal
+// with given id using the given context
+func (j *Journal) UploadAttachmentWithContext(ctx context.Context, id, filepath string, client *Client) error {
+	resp, err := client.upload(ctx, j.Endpoint()+"/"+id+"/attachment", "attachment", filepath)
+	_, err = SendResp(resp, err, j)
+	return err
+}
+
+// DownloadAttachment will download the attachment of the journal to the given filepath
+func (j *Journal) DownloadAttachment(id, filepath string, client *Client) error {
+	return j.DownloadAttachmentWithContext(context.Background(), id, filepath, client)
+}
+
+// DownloadAttachmentWithContext will download the attachment of the journal
+// to the given filepath using the given context
+func (j *Journal) DownloadAttachmentWithContext(ctx context.Context, id, filepath string, client *Client) error {
+	return client.download(ctx, j.Endpoint()+"/"+id+"/attachment", filepath)
+}
+
+// DeleteAttachment removes the attachment of the journal with given id
+func (j *Journal) DeleteAttachment(id string, client *Client) error {
+	return j.DeleteAttachmentWithContext(context.Background(), id, client)
+}
+
+// DeleteAttachmentWithContext removes the attachment of the journal with given
+// id using the given context
+func (j *Journal) DeleteAttachmentWithContext(ctx context.Context, id string, client *Client) error {
+	resp, err := client.DeleteWithContext(ctx, j.Endpoint()+"/"+id+"/attachment")
+	_, err = SendResp(resp, err, j)
+	return err
+}
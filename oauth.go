@@ -0,0 +1,136 @@
+package zohobooks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// ScopeFullAccess grants access to all the zohobooks APIs
+const ScopeFullAccess = "ZohoBooks.fullaccess.all"
+
+// token converts the oauth response into a Token
+func (r *OAuthResponse) token() *Token {
+	var t = &Token{
+		AccessToken:  r.AccessToken,
+		RefreshToken: r.RefreshToken,
+		TokenType:    r.TokenType,
+		APIDomain:    r.APIDomain,
+	}
+	if r.ExpiresIn > 0 {
+		t.Expiry = time.Now().Add(time.Duration(r.ExpiresIn) * time.Second)
+	}
+	return t
+}
+
+// GetOauthAuthURL returns the consent page URL of the accounts server for
+// the datacenter of the client
+func (c *Client) GetOauthAuthURL() string {
+	return strings.TrimSuffix(c.GetOauthURL(), "/token") + "/auth"
+}
+
+// GetOauthRevokeURL returns the token revocation URL of the accounts server
+// for the datacenter of the client
+func (c *Client) GetOauthRevokeURL() string {
+	return c.GetOauthURL() + "/revoke"
+}
+
+// AuthCodeURL returns the URL of the consent page to which the user is to be
+// redirected. Offline access is requested so that the code can be exchanged
+// for a refresh token, state is passed back to the redirect URI unchanged
+func (c *Client) AuthCodeURL(scopes []string, state string) string {
+	if len(scopes) == 0 {
+		scopes = []string{ScopeFullAccess}
+	}
+	var query = url.Values{}
+	query.Set("scope", strings.Join(scopes, ","))
+	query.Set("client_id", c.clientID)
+	query.Set("response_type", "code")
+	query.Set("access_type", "offline")
+	query.Set("prompt", "consent")
+	query.Set("redirect_uri", c.redirectURI)
+	if len(state) > 0 {
+		query.Set("state", state)
+	}
+	return c.GetOauthAuthURL() + "?" + query.Encode()
+}
+
+// ExchangeCode exchanges the authorization code for access and refresh tokens
+func (c *Client) ExchangeCode(code string) (*Token, error) {
+	return c.ExchangeCodeWithContext(context.Background(), code)
+}
+
+// ExchangeCodeWithContext exchanges the authorization code for access and
+// refresh tokens using the given context. The client keeps the refresh token
+// and saves the access token to its token store for the subsequent requests
+func (c *Client) ExchangeCodeWithContext(ctx context.Context, code string) (*Token, error) {
+	var params = url.Values{}
+	params.Set("code", code)
+	params.Set("client_id", c.clientID)
+	params.Set("client_secret", c.clientSecret)
+	params.Set("redirect_uri", c.redirectURI)
+	params.Set("grant_type", "authorization_code")
+	oauthResp, err := c.oauthRequest(ctx, c.GetOauthURL(), params)
+	if err != nil {
+		return nil, err
+	}
+	token := oauthResp.token()
+	if c.tokenStore != nil {
+		if err := c.tokenStore.Save(ctx, token); err != nil {
+			return token, err
+		}
+	}
+	c.state().mu.Lock()
+	c.OAuthToken = token.AccessToken
+	if len(token.RefreshToken) > 0 {
+		c.refreshToken = token.RefreshToken
+	}
+	c.state().mu.Unlock()
+	return token, nil
+}
+
+// RevokeToken revokes the given refresh or access token
+func (c *Client) RevokeToken(token string) error {
+	return c.RevokeTokenWithContext(context.Background(), token)
+}
+
+// RevokeTokenWithContext revokes the given refresh or access token using the
+// given context
+func (c *Client) RevokeTokenWithContext(ctx context.Context, token string) error {
+	var params = url.Values{}
+	params.Set("token", token)
+	_, err := c.oauthRequest(ctx, c.GetOauthRevokeURL(), params)
+	return err
+}
+
+// oauthRequest posts the parameters to the accounts server as a form body, they
+// hold the client secret and tokens which must not end up in the url as it is
+// included in the errors returned by the http client
+func (c *Client) oauthRequest(ctx context.Context, endpoint string, params url.Values) (*OAuthResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, strings.NewReader(params.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	body, readErr := readBody(resp)
+	if readErr != nil {
+		return nil, readErr
+	}
+	oauthResp := &OAuthResponse{}
+	parseError := json.Unmarshal(body, oauthResp)
+	if parseError != nil {
+		return nil, parseError
+	}
+	if oauthResp.Error != "" {
+		return nil, errors.New(oauthResp.Error)
+	}
+	return oauthResp, nil
+}
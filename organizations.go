@@ -0,0 +1,220 @@
+package zohobooks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// FiscalMonth is the month in which the fiscal year of an organization starts,
+// zohobooks returns it either as a month index (0 for january) or as a name
+type FiscalMonth time.Month
+
+// UnmarshalJSON decodes the month from its index or its name
+func (m *FiscalMonth) UnmarshalJSON(data []byte) error {
+	var index int
+	if err := json.Unmarshal(data, &index); err == nil {
+		if index < 0 || index > 11 {
+			return fmt.Errorf("zohobooks: invalid fiscal year start month %d", index)
+		}
+		*m = FiscalMonth(index + 1)
+		return nil
+	}
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return err
+	}
+	if len(name) == 0 {
+		*m = 0
+		return nil
+	}
+	for month := time.January; month <= time.December; month++ {
+		if strings.EqualFold(month.String(), name) {
+			*m = FiscalMonth(month)
+			return nil
+		}
+	}
+	return fmt.Errorf("zohobooks: invalid fiscal year start month %q", name)
+}
+
+// Month returns the fiscal month as a time.Month
+func (m FiscalMonth) Month() time.Month {
+	return time.Month(m)
+}
+
+// Organization struct represents the information of an organization the
+// user has access to
+type Organization struct {
+	ID                   string         `json:"organization_id"`
+	Name                 string         `json:"name"`
+	ContactName          string         `json:"contact_name"`
+	Email                string         `json:"email"`
+	IsDefaultOrg         bool           `json:"is_default_org"`
+	IsOrgActive          bool           `json:"is_org_active"`
+	PlanType             int            `json:"plan_type"`
+	PlanName             string         `json:"plan_name"`
+	AccountCreatedDate   string         `json:"account_created_date"`
+	Version              string         `json:"version"`
+	CurrencyID           string         `json:"currency_id"`
+	CurrencyCode         string         `json:"currency_code"`
+	CurrencySymbol       string         `json:"currency_symbol"`
+	CurrencyFormat       string         `json:"currency_format"`
+	PricePrecision       int            `json:"price_precision"`
+	FiscalYearStartMonth FiscalMonth    `json:"fiscal_year_start_month"`
+	TimeZone             string         `json:"time_zone"`
+	DateFormat           string         `json:"date_format"`
+	FieldSeparator       string         `json:"field_separator"`
+	LanguageCode         string         `json:"language_code"`
+	IndustryType         string         `json:"industry_type"`
+	Address              BillingAddress `json:"address"`
+}
+
+// BaseCurrency returns the base currency of the organization
+func (o *Organization) BaseCurrency() Currency {
+	return Currency{
+		ID:             o.CurrencyID,
+		Code:           o.CurrencyCode,
+		Symbol:         o.CurrencySymbol,
+		PricePrecision: o.PricePrecision,
+		IsBaseCurrency: true,
+		ExchangeRate:   1,
+	}
+}
+
+// Location returns the time zone of the organization
+func (o *Organization) Location() (*time.Location, error) {
+	return time.LoadLocation(o.TimeZone)
+}
+
+// OrganizationParams struct represents the information to update an organization
+type OrganizationParams struct {
+	Name                 string          `json:"name,omitempty"`
+	ContactName          string          `json:"contact_name,omitempty"`
+	Email                string          `json:"email,omitempty"`
+	FiscalYearStartMonth string          `json:"fiscal_year_start_month,omitempty"` // e.g. january
+	TimeZone             string          `json:"time_zone,omitempty"`
+	DateFormat           string          `json:"date_format,omitempty"`
+	FieldSeparator       string          `json:"field_separator,omitempty"`
+	LanguageCode         string          `json:"language_code,omitempty"`
+	IndustryType         string          `json:"industry_type,omitempty"`
+	Address              *BillingAddress `json:"address,omitempty"`
+}
+
+// New method will create an organization object and return a pointer to it
+func (o *Organization) New() Resource {
+	var obj = &Organization{}
+	return obj
+}
+
+// Endpoint method returns the endpoint of the resource
+func (o *Organization) Endpoint() string {
+	return "/organizations"
+}
+
+// FindOne tries to find the organization with given id
+func (o *Organization) FindOne(id string, client *Client) (*Organization, error) {
+	return o.FindOneWithContext(context.Background(), id, client)
+}
+
+// FindOneWithContext tries to find the organization with given id using the given context
+func (o *Organization) FindOneWithContext(ctx context.Context, id string, client *Client) (*Organization, error) {
+	resp, err := client.GetWithContext(ctx, o.Endpoint()+"/"+id)
+	respData, err := SendResp(resp, err, o)
+	if err != nil {
+		return o, err
+	}
+	return &respData.Organization, err
+}
+
+// FindAll will return the organizations accessible with the client token,
+// the client does not need an OrgID for this call
+func (o *Organization) FindAll(client *Client) ([]Organization, error) {
+	return o.FindAllWithContext(context.Background(), client)
+}
+
+// FindAllWithContext will return the accessible organizations using the given context
+func (o *Organization) FindAllWithContext(ctx context.Context, client *Client) ([]Organization, error) {
+	var results []Organization
+	resp, err := client.GetWithContext(ctx, o.Endpoint())
+	respData, err := SendResp(resp, err, o)
+	if err != nil {
+		return results, err
+	}
+	for _, org := range respData.Organizations {
+		results = append(results, org)
+	}
+	return results, nil
+}
+
+// Update method will try to update the organization on zohobooks
+func (o *Organization) Update(id string, params *OrganizationParams, client *Client) (*Organization, error) {
+	return o.UpdateWithContext(context.Background(), id, params, client)
+}
+
+// UpdateWithContext will try to update the organization using the given context
+func (o *Organization) UpdateWithContext(ctx context.Context, id string, params *OrganizationParams, client *Client) (*Organization, error) {
+	var body, _ = json.Marshal(params)
+	resp, err := client.PutWithContext(ctx, o.Endpoint()+"/"+id, string(body))
+
+	respData, err := SendResp(resp, err, o)
+	if err != nil {
+		return o, err
+	}
+	return &respData.Organization, err
+}
+
+// OrgClient pairs an organization with a client scoped to it
+type OrgClient struct {
+	Organization Organization
+	Client       *Client
+}
+
+// ForOrg returns a copy of the client scoped to the given organization, the
+// copy shares the token store, retry policy, rate limiter and http client, and
+// joins the token refreshes of the client instead of making its own
+func (c *Client) ForOrg(orgID string) *Client {
+	var tokens = c.state()
+	tokens.mu.Lock()
+	defer tokens.mu.Unlock()
+	return &Client{
+		tokens:       tokens,
+		Key:          c.Key,
+		OAuthToken:   c.OAuthToken,
+		clientID:     c.clientID,
+		clientSecret: c.clientSecret,
+		refreshToken: c.refreshToken,
+		redirectURI:  c.redirectURI,
+		OrgID:        orgID,
+		Datacenter:   c.Datacenter,
+		httpClient:   c.httpClient,
+		tokenStore:   c.tokenStore,
+		retry:        c.retry,
+		limiter:      c.limiter,
+	}
+}
+
+// Organizations discovers the organizations accessible with the client token
+// and returns a client scoped to each of them
+func (c *Client) Organizations() ([]OrgClient, error) {
+	return c.OrganizationsWithContext(context.Background())
+}
+
+// OrganizationsWithContext discovers the accessible organizations using the given context
+func (c *Client) OrganizationsWithContext(ctx context.Context) ([]OrgClient, error) {
+	var o = &Organization{}
+	orgs, err := o.FindAllWithContext(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	var results = make([]OrgClient, 0, len(orgs))
+	for _, org := range orgs {
+		// the daily quota of the organization renews in its time zone
+		if loc, err := org.Location(); c.limiter != nil && err == nil && len(org.TimeZone) > 0 {
+			c.limiter.SetLocation(org.ID, loc)
+		}
+		results = append(results, OrgClient{Organization: org, Client: c.ForOrg(org.ID)})
+	}
+	return results, nil
+}
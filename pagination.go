@@ -0,0 +1,116 @@
+package zohobooks
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strconv"
+)
+
+// MaxPerPage is the largest page size accepted by the list endpoints
+const MaxPerPage = 200
+
+// errStopIteration can be returned by a ForEach callback to stop early
+var errStopIteration = errors.New("stop iteration")
+
+// PageContext struct contains the pagination details of a list response
+type PageContext struct {
+	Page        int    `json:"page"`
+	PerPage     int    `json:"per_page"`
+	HasMorePage bool   `json:"has_more_page"`
+	SortColumn  string `json:"sort_column"`
+	SortOrder   string `json:"sort_order"`
+}
+
+// PageFunc fetches the given page of a list endpoint, pages start from 1
+type PageFunc[T any] func(ctx context.Context, page, perPage int) ([]T, *PageContext, error)
+
+// Iterator lazily fetches the pages of a list endpoint and yields their items
+// one at a time, following page_context.has_more_page
+type Iterator[T any] struct {
+	// PerPage is the page size requested from zohobooks, it defaults to MaxPerPage
+	PerPage int
+
+	fetch   PageFunc[T]
+	page    int
+	items   []T
+	pos     int
+	hasMore bool
+	cur     T
+	err     error
+}
+
+// NewIterator returns an iterator fetching the pages with the given function
+func NewIterator[T any](fetch PageFunc[T]) *Iterator[T] {
+	return &Iterator[T]{PerPage: MaxPerPage, fetch: fetch, hasMore: true}
+}
+
+// Next advances the iterator to the next item, fetching the next page when
+// needed. It returns false when there are no more items or an error occurred
+func (it *Iterator[T]) Next(ctx context.Context) bool {
+	for it.pos >= len(it.items) {
+		if it.err != nil || !it.hasMore {
+			return false
+		}
+		it.page++
+		items, pageCtx, err := it.fetch(ctx, it.page, it.PerPage)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.items, it.pos = items, 0
+		it.hasMore = pageCtx != nil && pageCtx.HasMorePage && len(items) > 0
+	}
+	it.cur = it.items[it.pos]
+	it.pos++
+	return true
+}
+
+// Item returns the current item of the iterator
+func (it *Iterator[T]) Item() T {
+	return it.cur
+}
+
+// Err returns the error which stopped the iterator, if any
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// ForEach calls fn for each remaining item, stopping at the first error
+func (it *Iterator[T]) ForEach(ctx context.Context, fn func(T) error) error {
+	for it.Next(ctx) {
+		if err := fn(it.Item()); err != nil {
+			return err
+		}
+	}
+	return it.Err()
+}
+
+// CollectAll returns all the remaining items of the iterator. When maxItems
+// is greater than 0 no more than maxItems items are returned
+func CollectAll[T any](ctx context.Context, it *Iterator[T], maxItems int) ([]T, error) {
+	var results []T
+	err := it.ForEach(ctx, func(item T) error {
+		results = append(results, item)
+		if maxItems > 0 && len(results) >= maxItems {
+			return errStopIteration
+		}
+		return nil
+	})
+	if err == errStopIteration {
+		err = nil
+	}
+	return results, err
+}
+
+// withPage appends the options to the path along with the pagination
+// parameters, which replace the page and page size of the options
+func withPage(path string, opts QueryOptions, page, perPage int) string {
+	var query = url.Values{}
+	if opts != nil {
+		query = opts.Values()
+	}
+	query.Set("page", strconv.Itoa(page))
+	query.Set("per_page", strconv.Itoa(perPage))
+	return appendQuery(path, query)
+}
@@ -0,0 +1,111 @@
+package zohobooks
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// pagesOf returns a PageFunc serving the given pages and recording the pages
+// requested
+func pagesOf(pages [][]int, requested *[]int) PageFunc[int] {
+	return func(ctx context.Context, page, perPage int) ([]int, *PageContext, error) {
+		*requested = append(*requested, page)
+		if page > len(pages) {
+			return nil, &PageContext{Page: page}, nil
+		}
+		return pages[page-1], &PageContext{Page: page, PerPage: perPage, HasMorePage: page < len(pages)}, nil
+	}
+}
+
+func TestIteratorFollowsHasMorePage(t *testing.T) {
+	var requested []int
+	it := NewIterator(pagesOf([][]int{{1, 2}, {3}, {4, 5}}, &requested))
+
+	items, err := CollectAll(context.Background(), it, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int{1, 2, 3, 4, 5}; !reflect.DeepEqual(items, want) {
+		t.Errorf("items = %v, want %v", items, want)
+	}
+	if want := []int{1, 2, 3}; !reflect.DeepEqual(requested, want) {
+		t.Errorf("requested pages = %v, want %v", requested, want)
+	}
+	if it.Next(context.Background()) {
+		t.Error("Next returned true after the last page")
+	}
+}
+
+func TestIteratorStopsOnEmptyPage(t *testing.T) {
+	var requested []int
+	it := NewIterator(func(ctx context.Context, page, perPage int) ([]int, *PageContext, error) {
+		requested = append(requested, page)
+		// a broken page context must not make the iterator loop forever
+		return nil, &PageContext{HasMorePage: true}, nil
+	})
+	if it.Next(context.Background()) {
+		t.Error("Next returned true for an empty page")
+	}
+	if len(requested) != 1 {
+		t.Errorf("requested pages = %v, want only the first one", requested)
+	}
+}
+
+func TestCollectAllMaxItems(t *testing.T) {
+	var requested []int
+	it := NewIterator(pagesOf([][]int{{1, 2}, {3, 4}, {5}}, &requested))
+
+	items, err := CollectAll(context.Background(), it, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int{1, 2, 3}; !reflect.DeepEqual(items, want) {
+		t.Errorf("items = %v, want %v", items, want)
+	}
+	if want := []int{1, 2}; !reflect.DeepEqual(requested, want) {
+		t.Errorf("requested pages = %v, want %v", requested, want)
+	}
+}
+
+func TestIteratorError(t *testing.T) {
+	var errPage = errors.New("page failed")
+	it := NewIterator(func(ctx context.Context, page, perPage int) ([]int, *PageContext, error) {
+		if page == 2 {
+			return nil, nil, errPage
+		}
+		return []int{page}, &PageContext{HasMorePage: true}, nil
+	})
+
+	items, err := CollectAll(context.Background(), it, 0)
+	if !errors.Is(err, errPage) {
+		t.Errorf("err = %v, want %v", err, errPage)
+	}
+	if !reflect.DeepEqual(items, []int{1}) {
+		t.Errorf("items = %v, want the items before the error", items)
+	}
+	if it.Next(context.Background()) {
+		t.Error("Next returned true after an error")
+	}
+}
+
+func TestForEachStopsOnCallbackError(t *testing.T) {
+	var requested []int
+	it := NewIterator(pagesOf([][]int{{1, 2}, {3}}, &requested))
+	var errStop = errors.New("stop")
+	var seen []int
+	err := it.ForEach(context.Background(), func(i int) error {
+		seen = append(seen, i)
+		if i == 2 {
+			return errStop
+		}
+		return nil
+	})
+	if err != errStop {
+		t.Errorf("err = %v, want the callback error", err)
+	}
+	if !reflect.DeepEqual(seen, []int{1, 2}) || len(requested) != 1 {
+		t.Errorf("seen = %v, requested = %v, want to stop on the first page", seen, requested)
+	}
+}
@@ -0,0 +1,127 @@
+package zohobooks
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// PaymentRefund struct represents the amount of a customer payment refunded
+type PaymentRefund struct {
+	ID              string  `json:"payment_refund_id"`
+	PaymentID       string  `json:"payment_id"`
+	Date            string  `json:"date"`
+	RefundMode      string  `json:"refund_mode"`
+	RefNo           string  `json:"reference_number"`
+	Amount          float64 `json:"amount"`
+	ExchangeRate    float64 `json:"exchange_rate"`
+	FromAccountID   string  `json:"from_account_id"`
+	FromAccountName string  `json:"from_account_name"`
+	Description     string  `json:"description"`
+}
+
+// PaymentRefundParams struct represents the information to refund a customer payment
+type PaymentRefundParams struct {
+	Date          string  `json:"date"`
+	RefundMode    string  `json:"refund_mode,omitempty"`
+	RefNo         string  `json:"reference_number,omitempty"`
+	Amount        float64 `json:"amount"`
+	ExchangeRate  float64 `json:"exchange_rate,omitempty"`
+	FromAccountID string  `json:"from_account_id"`
+	Description   string  `json:"description,omitempty"`
+}
+
+// New method will create a payment refund object and return a pointer to it
+func (pr *PaymentRefund) New() Resource {
+	var obj = &PaymentRefund{}
+	return obj
+}
+
+// Endpoint method returns the endpoint of the payments the refunds belong to
+func (pr *PaymentRefund) Endpoint() string {
+	return "/customerpayments"
+}
+
+func (pr *PaymentRefund) refundsEndpoint(paymentID string) string {
+	return pr.Endpoint() + "/" + paymentID + "/refunds"
+}
+
+// Create method will try to refund the payment with given id
+func (pr *PaymentRefund) Create(paymentID string, params *PaymentRefundParams, client *Client) (*PaymentRefund, error) {
+	return pr.CreateWithContext(context.Background(), paymentID, params, client)
+}
+
+// CreateWithContext will try to refund the payment with given id using the given context
+func (pr *PaymentRefund) CreateWithContext(ctx context.Context, paymentID string, params *PaymentRefundParams, client *Client) (*PaymentRefund, error) {
+	var body, _ = json.Marshal(params)
+	resp, err := client.PostWithContext(ctx, pr.refundsEndpoint(paymentID), string(body))
+
+	respData, err := SendResp(resp, err, pr)
+	if err != nil {
+		return pr, err
+	}
+	return &respData.PaymentRefund, err
+}
+
+// FindOne tries to find the refund with given id of the payment
+func (pr *PaymentRefund) FindOne(paymentID, id string, client *Client) (*PaymentRefund, error) {
+	return pr.FindOneWithContext(context.Background(), paymentID, id, client)
+}
+
+// FindOneWithContext tries to find the refund with given id of the payment using the given context
+func (pr *PaymentRefund) FindOneWithContext(ctx context.Context, paymentID, id string, client *Client) (*PaymentRefund, error) {
+	resp, err := client.GetWithContext(ctx, pr.refundsEndpoint(paymentID)+"/"+id)
+	respData, err := SendResp(resp, err, pr)
+	if err != nil {
+		return pr, err
+	}
+	return &respData.PaymentRefund, err
+}
+
+// FindAll returns the refunds of the payment with given id
+func (pr *PaymentRefund) FindAll(paymentID string, client *Client) ([]PaymentRefund, error) {
+	return pr.FindAllWithContext(context.Background(), paymentID, client)
+}
+
+// FindAllWithContext returns the refunds of the payment with given id using the given context
+func (pr *PaymentRefund) FindAllWithContext(ctx context.Context, paymentID string, client *Client) ([]PaymentRefund, error) {
+	resp, err := client.GetWithContext(ctx, pr.refundsEndpoint(paymentID))
+	respData, err := SendResp(resp, err, pr)
+
+	var results []PaymentRefund
+	if err != nil {
+		return results, err
+	}
+	for _, refund := range respData.PaymentRefunds {
+		results = append(results, refund)
+	}
+	return results, err
+}
+
+// Update method will try to update the refund with given id of the payment
+func (pr *PaymentRefund) Update(paymentID, id string, params *PaymentRefundParams, client *Client) (*PaymentRefund, error) {
+	return pr.UpdateWithContext(context.Background(), paymentID, id, params, client)
+}
+
+// UpdateWithContext will try to update the refund of the payment using the given context
+func (pr *PaymentRefund) UpdateWithContext(ctx context.Context, paymentID, id string, params *PaymentRefundParams, client *Client) (*PaymentRefund, error) {
+	var body, _ = json.Marshal(params)
+	resp, err := client.PutWithContext(ctx, pr.refundsEndpoint(paymentID)+"/"+id, string(body))
+
+	respData, err := SendResp(resp, err, pr)
+	if err != nil {
+		return pr, err
+	}
+	return &respData.PaymentRefund, err
+}
+
+// Delete tries to delete the refund with given id of the payment
+func (pr *PaymentRefund) Delete(paymentID, id string, client *Client) error {
+	return pr.DeleteWithContext(context.Background(), paymentID, id, client)
+}
+
+// DeleteWithContext tries to delete the refund of the payment using the given context
+func (pr *PaymentRefund) DeleteWithContext(ctx context.Context, paymentID, id string, client *Client) error {
+	resp, err := client.DeleteWithContext(ctx, pr.refundsEndpoint(paymentID)+"/"+id)
+	_, err = SendResp(resp, err, pr)
+	return err
+}
@@ -1,8 +1,10 @@
 package zohobooks
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
+	"net/url"
 )
 
 type InvoiceInfo struct {
@@ -20,6 +22,7 @@ type Payment struct {
 	Mode           string        `json:"payment_mode"`
 	Amount         float64       `json:"amount"`
 	AmountRefunded float64       `json:"amount_refunded"`
+	UnusedAmount   float64       `json:"unused_amount"` // not yet applied to invoices
 	BankCharges    float64       `json:"bank_charges"`
 	Date           string        `json:"date"`
 	Status         string        `json:"status"`
@@ -35,6 +38,17 @@ type Payment struct {
 
 type PaymentFindOptions struct {
 	CustomerID string
+	ListOptions
+}
+
+// Values encodes the options as query parameters
+func (o *PaymentFindOptions) Values() url.Values {
+	if o == nil {
+		return url.Values{}
+	}
+	var v = o.ListOptions.Values()
+	setString(v, "customer_id", o.CustomerID)
+	return v
 }
 
 type PaymentParams struct {
@@ -65,8 +79,30 @@ func (p *Payment) Endpoint() string {
 
 // Create method will try to create a contact on razorpay
 func (p *Payment) Create(params *PaymentParams, client *Client) (*Payment, error) {
+	return p.CreateWithContext(context.Background(), params, client)
+}
+
+// CreateWithContext will try to create a payment using the given context
+func (p *Payment) CreateWithContext(ctx context.Context, params *PaymentParams, client *Client) (*Payment, error) {
+	var body, _ = json.Marshal(params)
+	resp, err := client.PostWithContext(ctx, p.Endpoint(), string(body))
+
+	respData, err := SendResp(resp, err, p)
+	if err != nil {
+		return p, err
+	}
+	return &respData.Payment, err
+}
+
+// Update method will try to update a payment on zohobooks
+func (p *Payment) Update(id string, params *PaymentParams, client *Client) (*Payment, error) {
+	return p.UpdateWithContext(context.Background(), id, params, client)
+}
+
+// UpdateWithContext will try to update a payment using the given context
+func (p *Payment) UpdateWithContext(ctx context.Context, id string, params *PaymentParams, client *Client) (*Payment, error) {
 	var body, _ = json.Marshal(params)
-	resp, err := client.Post(p.Endpoint(), string(body))
+	resp, err := client.PutWithContext(ctx, p.Endpoint()+"/"+id, string(body))
 
 	respData, err := SendResp(resp, err, p)
 	if err != nil {
@@ -77,7 +113,12 @@ func (p *Payment) Create(params *PaymentParams, client *Client) (*Payment, error
 
 // FindOne tries to find the contact with given id
 func (p *Payment) FindOne(id string, client *Client) (*Payment, error) {
-	resp, err := client.Get(p.Endpoint() + "/" + id)
+	return p.FindOneWithContext(context.Background(), id, client)
+}
+
+// FindOneWithContext tries to find the payment with given id using the given context
+func (p *Payment) FindOneWithContext(ctx context.Context, id string, client *Client) (*Payment, error) {
+	resp, err := client.GetWithContext(ctx, p.Endpoint()+"/"+id)
 	respData, err := SendResp(resp, err, p)
 	if err != nil {
 		return p, err
@@ -87,7 +128,12 @@ func (p *Payment) FindOne(id string, client *Client) (*Payment, error) {
 
 // Delete tries to delete the payment with given id
 func (p *Payment) Delete(id string, client *Client) error {
-	resp, err := client.Delete(p.Endpoint() + "/" + id)
+	return p.DeleteWithContext(context.Background(), id, client)
+}
+
+// DeleteWithContext tries to delete the payment with given id using the given context
+func (p *Payment) DeleteWithContext(ctx context.Context, id string, client *Client) error {
+	resp, err := client.DeleteWithContext(ctx, p.Endpoint()+"/"+id)
 	respData, err := SendResp(resp, err, p)
 	if err != nil {
 		return err
@@ -100,7 +146,12 @@ func (p *Payment) Delete(id string, client *Client) error {
 
 // FindAll tries to find the payment with given options
 func (p *Payment) FindAll(opts *PaymentFindOptions, client *Client) ([]Payment, error) {
-	resp, err := client.Get(p.Endpoint())
+	return p.FindAllWithContext(context.Background(), opts, client)
+}
+
+// FindAllWithContext tries to find the payments with given options using the given context
+func (p *Payment) FindAllWithContext(ctx context.Context, opts *PaymentFindOptions, client *Client) ([]Payment, error) {
+	resp, err := client.GetWithContext(ctx, withQuery(p.Endpoint(), opts))
 	respData, err := SendResp(resp, err, p)
 
 	var results []Payment
@@ -112,3 +163,15 @@ func (p *Payment) FindAll(opts *PaymentFindOptions, client *Client) ([]Payment,
 	}
 	return results, err
 }
+
+// Iterator returns an iterator over all the pages of payments with given options
+func (p *Payment) Iterator(opts *PaymentFindOptions, client *Client) *Iterator[Payment] {
+	return NewIterator(func(ctx context.Context, page, perPage int) ([]Payment, *PageContext, error) {
+		resp, err := client.GetWithContext(ctx, withPage(p.Endpoint(), opts, page, perPage))
+		respData, err := SendResp(resp, err, p)
+		if err != nil {
+			return nil, nil, err
+		}
+		return respData.Payments, &respData.PageContext, nil
+	})
+}
@@ -0,0 +1,288 @@
+package zohobooks
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+)
+
+// PurchaseOrder struct represents the information of a purchase order
+type PurchaseOrder struct {
+	ID                  string `json:"purchaseorder_id"`
+	PurchaseOrderNumber string `json:"purchaseorder_number"`
+	VendorID            string `json:"vendor_id"`
+	VendorName          string `json:"vendor_name"`
+
+	// possible values ---> vat_registered,vat_not_registered,gcc_vat_not_registered,gcc_vat_registered,non_gcc,dz_vat_registered and dz_vat_not_registered.
+	TaxTreatment   string `json:"tax_treatment"`
+	GstNO          string `json:"gst_no"`        // 15 digit
+	GstTreatment   string `json:"gst_treatment"` // Allowed values are business_gst , business_none , overseas , consumer
+	SourceOfSupply string `json:"source_of_supply"`
+	DestOfSupply   string `json:"destination_of_supply"`
+
+	Status               string     `json:"status"`        // draft, open, billed or cancelled
+	BilledStatus         string     `json:"billed_status"` // billed, partially_billed or not_billed
+	Date                 string     `json:"date"`
+	DeliveryDate         string     `json:"delivery_date"`
+	ExpectedDeliveryDate string     `json:"expected_delivery_date"`
+	CurrencyCode         string     `json:"currency_code"`
+	CurrencyID           string     `json:"currency_id"`
+	ExchangeRate         float64    `json:"exchange_rate"`
+	IsInclusiveTax       bool       `json:"is_inclusive_tax"`
+	RefNo                string     `json:"reference_number"`
+	LineItems            []LineItem `json:"line_items"`
+	Notes                string     `json:"notes"`
+	Terms                string     `json:"terms"`
+	BranchID             string     `json:"branch_id"`
+
+	SubTotal float64   `json:"sub_total"`
+	TaxTotal float64   `json:"tax_total"`
+	Total    float64   `json:"total"`
+	Taxes    []taxInfo `json:"taxes"`
+
+	CreatedTime      string `json:"created_time"`
+	LastModifiedTime string `json:"last_modified_time"`
+
+	BillingAddress  BillingAddress `json:"billing_address"`
+	DeliveryAddress BillingAddress `json:"delivery_address"`
+}
+
+// PurchaseOrderParams struct represents the information to create a purchase order
+type PurchaseOrderParams struct {
+	VendorID            string `json:"vendor_id"`
+	PurchaseOrderNumber string `json:"purchaseorder_number,omitempty"`
+	RefNo               string `json:"reference_number,omitempty"`
+
+	// possible values ---> vat_registered,vat_not_registered,gcc_vat_not_registered,gcc_vat_registered,non_gcc,dz_vat_registered and dz_vat_not_registered.
+	TaxTreatment   string `json:"tax_treatment,omitempty"`
+	GstNO          string `json:"gst_no,omitempty"`        // 15 digit
+	GstTreatment   string `json:"gst_treatment,omitempty"` // Allowed values are business_gst , business_none , overseas , consumer
+	SourceOfSupply string `json:"source_of_supply,omitempty"`
+	DestOfSupply   string `json:"destination_of_supply,omitempty"`
+
+	Date           string     `json:"date,omitempty"`
+	DeliveryDate   string     `json:"delivery_date,omitempty"`
+	ExchangeRate   float64    `json:"exchange_rate,omitempty"`
+	IsInclusiveTax bool       `json:"is_inclusive_tax"`
+	LineItems      []LineItem `json:"line_items"`
+	Notes          string     `json:"notes,omitempty"`
+	Terms          string     `json:"terms,omitempty"`
+	BranchID       string     `json:"branch_id,omitempty"`
+
+	BillingAddressID string `json:"billing_address_id,omitempty"`
+}
+
+// PurchaseOrderFindOptions struct contains the filters used to list purchase orders
+type PurchaseOrderFindOptions struct {
+	VendorID            string
+	PurchaseOrderNumber string
+	ReferenceNo         string
+	Status              string
+	ListOptions
+}
+
+// Values encodes the options as query parameters
+func (o *PurchaseOrderFindOptions) Values() url.Values {
+	if o == nil {
+		return url.Values{}
+	}
+	var v = o.ListOptions.Values()
+	setString(v, "vendor_id", o.VendorID)
+	setString(v, "purchaseorder_number", o.PurchaseOrderNumber)
+	setString(v, "reference_number", o.ReferenceNo)
+	setString(v, "status", o.Status)
+	return v
+}
+
+// New method will create a purchase order object and return a pointer to it
+func (po *PurchaseOrder) New() Resource {
+	var obj = &PurchaseOrder{}
+	return obj
+}
+
+// Endpoint method returns the endpoint of the resource
+func (po *PurchaseOrder) Endpoint() string {
+	return "/purchaseorders"
+}
+
+// Create method will try to create a purchase order on zohobooks
+func (po *PurchaseOrder) Create(params *PurchaseOrderParams, client *Client) (*PurchaseOrder, error) {
+	return po.CreateWithContext(context.Background(), params, client)
+}
+
+// CreateWithContext will try to create a purchase order using the given context
+func (po *PurchaseOrder) CreateWithContext(ctx context.Context, params *PurchaseOrderParams, client *Client) (*PurchaseOrder, error) {
+	var body, _ = json.Marshal(params)
+	resp, err := client.PostWithContext(ctx, po.Endpoint(), string(body))
+
+	respData, err := SendResp(resp, err, po)
+	if err != nil {
+		return po, err
+	}
+	return &respData.PurchaseOrder, err
+}
+
+// FindOne tries to find the purchase order with given id
+func (po *PurchaseOrder) FindOne(id string, client *Client) (*PurchaseOrder, error) {
+	return po.FindOneWithContext(context.Background(), id, client)
+}
+
+// FindOneWithContext tries to find the purchase order with given id using the given context
+func (po *PurchaseOrder) FindOneWithContext(ctx context.Context, id string, client *Client) (*PurchaseOrder, error) {
+	resp, err := client.GetWithContext(ctx, po.Endpoint()+"/"+id)
+	respData, err := SendResp(resp, err, po)
+	if err != nil {
+		return po, err
+	}
+	return &respData.PurchaseOrder, err
+}
+
+// FindAll tries to find the purchase orders with given options
+func (po *PurchaseOrder) FindAll(opts *PurchaseOrderFindOptions, client *Client) ([]PurchaseOrder, error) {
+	return po.FindAllWithContext(context.Background(), opts, client)
+}
+
+// FindAllWithContext tries to find the purchase orders with given options using the given context
+func (po *PurchaseOrder) FindAllWithContext(ctx context.Context, opts *PurchaseOrderFindOptions, client *Client) ([]PurchaseOrder, error) {
+	resp, err := client.GetWithContext(ctx, withQuery(po.Endpoint(), opts))
+	respData, err := SendResp(resp, err, po)
+
+	var results []PurchaseOrder
+	if err != nil {
+		return results, err
+	}
+	for _, order := range respData.PurchaseOrders {
+		results = append(results, order)
+	}
+	return results, err
+}
+
+// Iterator returns an iterator over all the pages of purchase orders with given options
+func (po *PurchaseOrder) Iterator(opts *PurchaseOrderFindOptions, client *Client) *Iterator[PurchaseOrder] {
+	return NewIterator(func(ctx context.Context, page, perPage int) ([]PurchaseOrder, *PageContext, error) {
+		resp, err := client.GetWithContext(ctx, withPage(po.Endpoint(), opts, page, perPage))
+		respData, err := SendResp(resp, err, po)
+		if err != nil {
+			return nil, nil, err
+		}
+		return respData.PurchaseOrders, &respData.PageContext, nil
+	})
+}
+
+// Update method will try to update a purchase order on zohobooks
+func (po *PurchaseOrder) Update(id string, params *PurchaseOrderParams, client *Client) (*PurchaseOrder, error) {
+	return po.UpdateWithContext(context.Background(), id, params, client)
+}
+
+// UpdateWithContext will try to update a purchase order using the given context
+func (po *PurchaseOrder) UpdateWithContext(ctx context.Context, id string, params *PurchaseOrderParams, client *Client) (*PurchaseOrder, error) {
+	var body, _ = json.Marshal(params)
+	resp, err := client.PutWithContext(ctx, po.Endpoint()+"/"+id, string(body))
+
+	respData, err := SendResp(resp, err, po)
+	if err != nil {
+		return po, err
+	}
+	return &respData.PurchaseOrder, err
+}
+
+// Delete tries to delete the purchase order with given id
+func (po *PurchaseOrder) Delete(id string, client *Client) error {
+	return po.DeleteWithContext(context.Background(), id, client)
+}
+
+// DeleteWithContext tries to delete the purchase order with given id using the given context
+func (po *PurchaseOrder) DeleteWithContext(ctx context.Context, id string, client *Client) error {
+	resp, err := client.DeleteWithContext(ctx, po.Endpoint()+"/"+id)
+	_, err = SendResp(resp, err, po)
+	return err
+}
+
+// MarkIssued marks the purchase order with given id as issued to the vendor
+func (po *PurchaseOrder) MarkIssued(id string, client *Client) error {
+	return po.MarkIssuedWithContext(context.Background(), id, client)
+}
+
+// MarkIssuedWithContext marks the purchase order with given id as issued using the given context
+func (po *PurchaseOrder) MarkIssuedWithContext(ctx context.Context, id string, client *Client) error {
+	resp, err := client.postAction(ctx, po.Endpoint()+"/"+id+"/status/issued")
+	_, err = SendResp(resp, err, po)
+	return err
+}
+
+// MarkCancelled marks the purchase order with given id as cancelled
+func (po *PurchaseOrder) MarkCancelled(id string, client *Client) error {
+	return po.MarkCancelledWithContext(context.Background(), id, client)
+}
+
+// MarkCancelledWithContext marks the purchase order with given id as cancelled using the given context
+func (po *PurchaseOrder) MarkCancelledWithContext(ctx context.Context, id string, client *Client) error {
+	resp, err := client.postAction(ctx, po.Endpoint()+"/"+id+"/status/cancelled")
+	_, err = SendResp(resp, err, po)
+	return err
+}
+
+// Email method will send the purchase order to the vendor
+func (po *PurchaseOrder) Email(id string, params *EmailParams, client *Client) error {
+	return po.EmailWithContext(context.Background(), id, params, client)
+}
+
+// EmailWithContext will send the purchase order to the vendor using the given context
+func (po *PurchaseOrder) EmailWithContext(ctx context.Context, id string, params *EmailParams, client *Client) error {
+	var body, _ = json.Marshal(params)
+	resp, err := client.PostWithContext(ctx, po.Endpoint()+"/"+id+"/email", string(body))
+
+	_, err = SendResp(resp, err, po)
+	return err
+}
+
+// DownloadPDF method will download the pdf to the given filepath
+func (po *PurchaseOrder) DownloadPDF(id, filepath string, client *Client) error {
+	return po.DownloadPDFWithContext(context.Background(), id, filepath, client)
+}
+
+// DownloadPDFWithContext will download the pdf to the given filepath using the given context
+func (po *PurchaseOrder) DownloadPDFWithContext(ctx context.Context, id, filepath string, client *Client) error {
+	return client.download(ctx, appendQuery(po.Endpoint()+"/pdf", url.Values{"purchaseorder_ids": {id}}), filepath)
+}
+
+// ConvertToBill creates a bill with the given number for the purchase order
+func (po *PurchaseOrder) ConvertToBill(id, billNumber string, client *Client) (*Bill, error) {
+	return po.ConvertToBillWithContext(context.Background(), id, billNumber, client)
+}
+
+// ConvertToBillWithContext creates a bill with the given number for the
+// purchase order using the given context. The bill is created from the draft
+// zohobooks prepares for the purchase order, so its line items stay linked
+// to the ones of the purchase order
+func (po *PurchaseOrder) ConvertToBillWithContext(ctx context.Context, id, billNumber string, client *Client) (*Bill, error) {
+	var bill = &Bill{}
+	resp, err := client.GetWithContext(ctx, appendQuery(bill.Endpoint()+"/editpage/frompurchaseorders", url.Values{"purchaseorder_ids": {id}}))
+	respData, err := SendResp(resp, err, bill)
+	if err != nil {
+		return nil, err
+	}
+	var draft = respData.Bill
+	var params = &BillParams{
+		VendorID:          draft.VendorID,
+		BillNumber:        billNumber,
+		RefNo:             draft.RefNo,
+		TaxTreatment:      draft.TaxTreatment,
+		GstNO:             draft.GstNO,
+		GstTreatment:      draft.GstTreatment,
+		SourceOfSupply:    draft.SourceOfSupply,
+		DestOfSupply:      draft.DestOfSupply,
+		Date:              draft.Date,
+		DueDate:           draft.DueDate,
+		PaymentTerms:      draft.PaymentTerms,
+		PaymentTermsLabel: draft.PaymentTermsLabel,
+		ExchangeRate:      draft.ExchangeRate,
+		IsInclusiveTax:    draft.IsInclusiveTax,
+		LineItems:         draft.LineItems,
+		Notes:             draft.Notes,
+		Terms:             draft.Terms,
+		BranchID:          draft.BranchID,
+		PurchaseOrderIDs:  []string{id},
+	}
+	return bill.CreateWithContext(ctx, params, client)
+}
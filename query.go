@@ -0,0 +1,99 @@
+package zohobooks
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// queryDateFormat is the format of the dates passed as query parameters
+const queryDateFormat = "2006-01-02"
+
+// SortOrder is the order in which a list endpoint sorts its results
+type SortOrder string
+
+// SortAscending sorts the results in ascending order
+const SortAscending SortOrder = "A"
+
+// SortDescending sorts the results in descending order
+const SortDescending SortOrder = "D"
+
+// QueryOptions interface is implemented by the find options of the list
+// endpoints to encode themselves as query parameters
+type QueryOptions interface {
+	Values() url.Values
+}
+
+// ListOptions struct contains the query parameters shared by the list
+// endpoints, it is embedded in the find options of each resource
+type ListOptions struct {
+	Page       int
+	PerPage    int
+	SortColumn string
+	SortOrder  SortOrder
+	SearchText string
+	FilterBy   string // e.g. Status.All, Status.Active
+	DateStart  time.Time
+	DateEnd    time.Time
+}
+
+// Values encodes the options as query parameters, zero values are omitted
+func (o *ListOptions) Values() url.Values {
+	var v = url.Values{}
+	if o == nil {
+		return v
+	}
+	setInt(v, "page", o.Page)
+	setInt(v, "per_page", o.PerPage)
+	setString(v, "sort_column", o.SortColumn)
+	setString(v, "sort_order", string(o.SortOrder))
+	setString(v, "search_text", o.SearchText)
+	setString(v, "filter_by", o.FilterBy)
+	setDate(v, "date_start", o.DateStart)
+	setDate(v, "date_end", o.DateEnd)
+	return v
+}
+
+func setString(v url.Values, key, value string) {
+	if len(value) > 0 {
+		v.Set(key, value)
+	}
+}
+
+func setInt(v url.Values, key string, value int) {
+	if value > 0 {
+		v.Set(key, strconv.Itoa(value))
+	}
+}
+
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+func setDate(v url.Values, key string, value time.Time) {
+	if !value.IsZero() {
+		v.Set(key, value.Format(queryDateFormat))
+	}
+}
+
+// appendQuery adds the encoded values to the query string of the path, a
+// query already present in the path is kept as is
+func appendQuery(path string, values url.Values) string {
+	if len(values) == 0 {
+		return path
+	}
+	var sep = "?"
+	if strings.Contains(path, "?") {
+		sep = "&"
+	}
+	return path + sep + values.Encode()
+}
+
+// withQuery appends the encoded options to the path
+func withQuery(path string, opts QueryOptions) string {
+	if opts == nil {
+		return path
+	}
+	return appendQuery(path, opts.Values())
+}
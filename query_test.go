@@ -0,0 +1,81 @@
+package zohobooks
+
+import (
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestAppendQuery(t *testing.T) {
+	var tests = []struct {
+		path   string
+		values url.Values
+		want   string
+	}{
+		{"/contacts", nil, "/contacts"},
+		{"/contacts", url.Values{"search_text": {"a&b c"}}, "/contacts?search_text=a%26b+c"},
+		{"/invoices/pdf?invoice_ids=1;2", nil, "/invoices/pdf?invoice_ids=1;2"},
+		{"/invoices/pdf?invoice_ids=1;2", url.Values{"organization_id": {"9"}}, "/invoices/pdf?invoice_ids=1;2&organization_id=9"},
+	}
+	for _, tt := range tests {
+		if got := appendQuery(tt.path, tt.values); got != tt.want {
+			t.Errorf("appendQuery(%q, %v) = %q, want %q", tt.path, tt.values, got, tt.want)
+		}
+	}
+}
+
+func TestListOptionsValues(t *testing.T) {
+	var opts = &ContactFindOptions{
+		EmailContains: "a+b@example.com",
+		ListOptions: ListOptions{
+			PerPage:    50,
+			SortColumn: "contact_name",
+			SortOrder:  SortDescending,
+			SearchText: "acme & co",
+			DateStart:  time.Date(2024, 1, 2, 15, 0, 0, 0, time.UTC),
+		},
+	}
+	var want = "date_start=2024-01-02&email_contains=a%2Bb%40example.com&per_page=50" +
+		"&search_text=acme+%26+co&sort_column=contact_name&sort_order=D"
+	if got := opts.Values().Encode(); got != want {
+		t.Errorf("Values() = %q, want %q", got, want)
+	}
+
+	var nilOpts *ContactFindOptions
+	if got := withQuery("/contacts", nilOpts); got != "/contacts" {
+		t.Errorf("withQuery with nil options = %q, want /contacts", got)
+	}
+}
+
+func TestWithPage(t *testing.T) {
+	var opts = &ContactFindOptions{ListOptions: ListOptions{Page: 7, PerPage: 10, FilterBy: "Status.Active"}}
+	var want = "/contacts?filter_by=Status.Active&page=2&per_page=200"
+	if got := withPage("/contacts", opts, 2, MaxPerPage); got != want {
+		t.Errorf("withPage = %q, want %q", got, want)
+	}
+	if got := withPage("/settings/taxes", nil, 1, 20); got != "/settings/taxes?page=1&per_page=20" {
+		t.Errorf("withPage without options = %q", got)
+	}
+}
+
+func TestBankAccountFindOptionsPrecedence(t *testing.T) {
+	var opts = &BankAccountFindOptions{
+		FilterBy:    "Status.Active",
+		ListOptions: ListOptions{FilterBy: "Status.All", SortColumn: "account_name"},
+	}
+	v := opts.Values()
+	if got := v.Get("filter_by"); got != "Status.Active" {
+		t.Errorf("filter_by = %q, want the deprecated field to take precedence", got)
+	}
+	if got := v.Get("sort_column"); got != "account_name" {
+		t.Errorf("sort_column = %q, want the ListOptions value", got)
+	}
+}
+
+func TestClientURL(t *testing.T) {
+	c := NewClient("", "9", "in")
+	var want = BaseURLIN + "/invoices/pdf?invoice_ids=1%3B2&organization_id=9"
+	if got := c.getURL(appendQuery("/invoices/pdf", url.Values{"invoice_ids": {"1;2"}})); got != want {
+		t.Errorf("getURL = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,179 @@
+package zohobooks
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// DefaultRequestsPerMinute is the number of requests zohobooks allows per
+// minute for an organization
+const DefaultRequestsPerMinute = 100
+
+// RateLimiter throttles the requests made for each organization so that they
+// stay within the zohobooks API quotas. Requests are blocked until they can
+// be made instead of failing. A limiter can be shared by the clients of
+// several organizations, each organization has its own budget
+type RateLimiter struct {
+	perMinute int
+	perDay    int
+
+	mu    sync.Mutex
+	orgs  map[string]*orgBucket
+	zones map[string]*time.Location
+}
+
+// orgBucket is the token bucket of a single organization along with the
+// count of requests made in the current day
+type orgBucket struct {
+	tokens   float64
+	last     time.Time
+	dayEnd   time.Time
+	dayCount int
+}
+
+// RateLimitStats struct contains the remaining budget of an organization
+type RateLimitStats struct {
+	// Remaining is the number of requests which can be made right away
+	Remaining int
+	// RemainingToday is the number of requests left in the daily quota, it
+	// is -1 when there is no daily quota
+	RemainingToday int
+	// DayResetAt is the time at which the daily quota is renewed, the next
+	// midnight in the time zone of the organization
+	DayResetAt time.Time
+}
+
+// NewRateLimiter returns a limiter allowing perMinute requests per minute and
+// perDay requests per day for each organization. A perDay of 0 disables the
+// daily quota and a perMinute of 0 uses DefaultRequestsPerMinute. The daily
+// quota renews at midnight UTC unless SetLocation is called for the org
+func NewRateLimiter(perMinute, perDay int) *RateLimiter {
+	if perMinute <= 0 {
+		perMinute = DefaultRequestsPerMinute
+	}
+	return &RateLimiter{
+		perMinute: perMinute,
+		perDay:    perDay,
+		orgs:      map[string]*orgBucket{},
+		zones:     map[string]*time.Location{},
+	}
+}
+
+// SetLocation sets the time zone in which the daily quota of the organization
+// renews, it is the time zone of the organization in zohobooks
+func (l *RateLimiter) SetLocation(orgID string, loc *time.Location) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.zones[orgID] = loc
+	if b, ok := l.orgs[orgID]; ok {
+		b.dayEnd = l.nextMidnight(orgID, b.last)
+	}
+}
+
+// nextMidnight returns the start of the day following t in the time zone of
+// the organization, it must be called with the lock held
+func (l *RateLimiter) nextMidnight(orgID string, t time.Time) time.Time {
+	var loc = time.UTC
+	if zone, ok := l.zones[orgID]; ok && zone != nil {
+		loc = zone
+	}
+	t = t.In(loc)
+	return time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+}
+
+func (l *RateLimiter) newBucket(orgID string, now time.Time) *orgBucket {
+	return &orgBucket{tokens: float64(l.perMinute), last: now, dayEnd: l.nextMidnight(orgID, now)}
+}
+
+// bucket returns the refilled bucket of the organization, it must be called
+// with the lock held
+func (l *RateLimiter) bucket(orgID string, now time.Time) *orgBucket {
+	b, ok := l.orgs[orgID]
+	if !ok {
+		b = l.newBucket(orgID, now)
+		l.orgs[orgID] = b
+	}
+	b.tokens += now.Sub(b.last).Minutes() * float64(l.perMinute)
+	if b.tokens > float64(l.perMinute) {
+		b.tokens = float64(l.perMinute)
+	}
+	b.last = now
+	if !now.Before(b.dayEnd) {
+		b.dayEnd = l.nextMidnight(orgID, now)
+		b.dayCount = 0
+	}
+	return b
+}
+
+// reserve takes a token from the bucket of the organization, when none is
+// available it returns how long to wait before trying again
+func (l *RateLimiter) reserve(orgID string) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	var now = time.Now()
+	b := l.bucket(orgID, now)
+	if l.perDay > 0 && b.dayCount >= l.perDay {
+		return b.dayEnd.Sub(now)
+	}
+	if b.tokens < 1 {
+		return time.Duration((1 - b.tokens) / float64(l.perMinute) * float64(time.Minute))
+	}
+	b.tokens--
+	b.dayCount++
+	return 0
+}
+
+// Wait blocks until a request can be made for the organization or the
+// context is done
+func (l *RateLimiter) Wait(ctx context.Context, orgID string) error {
+	for {
+		delay := l.reserve(orgID)
+		if delay <= 0 {
+			return nil
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// Stats returns the remaining budget of the organization, an organization
+// for which no request has been made has its full budget
+func (l *RateLimiter) Stats(orgID string) RateLimitStats {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	var now = time.Now()
+	var b *orgBucket
+	if _, ok := l.orgs[orgID]; ok {
+		b = l.bucket(orgID, now)
+	} else {
+		b = l.newBucket(orgID, now)
+	}
+	var stats = RateLimitStats{
+		Remaining:      int(b.tokens),
+		RemainingToday: -1,
+		DayResetAt:     b.dayEnd,
+	}
+	if l.perDay > 0 {
+		stats.RemainingToday = l.perDay - b.dayCount
+		if stats.Remaining > stats.RemainingToday {
+			stats.Remaining = stats.RemainingToday
+		}
+	}
+	return stats
+}
+
+// RateLimitStats returns the remaining budget of the organization of the
+// client, it returns nil when the client has no rate limiter
+func (c *Client) RateLimitStats() *RateLimitStats {
+	if c.limiter == nil {
+		return nil
+	}
+	stats := c.limiter.Stats(c.OrgID)
+	return &stats
+}
@@ -0,0 +1,90 @@
+package zohobooks
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterBucket(t *testing.T) {
+	var l = NewRateLimiter(60, 0)
+	var now = time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	b := l.bucket("1", now)
+	b.tokens = 0
+	if got := l.bucket("1", now.Add(30*time.Second)).tokens; got != 30 {
+		t.Errorf("tokens after 30s = %v, want 30", got)
+	}
+	if got := l.bucket("1", now.Add(5*time.Minute)).tokens; got != 60 {
+		t.Errorf("tokens after 5m = %v, want the bucket capped at 60", got)
+	}
+	if got := l.bucket("2", now).tokens; got != 60 {
+		t.Errorf("tokens of another org = %v, want 60", got)
+	}
+}
+
+func TestRateLimiterWait(t *testing.T) {
+	var l = NewRateLimiter(600, 0)
+	var ctx = context.Background()
+	for i := 0; i < 600; i++ {
+		if err := l.Wait(ctx, "1"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// the bucket is empty, a token is added every 100ms
+	var start = time.Now()
+	if err := l.Wait(ctx, "1"); err != nil {
+		t.Fatal(err)
+	}
+	if d := time.Since(start); d < 50*time.Millisecond {
+		t.Errorf("Wait on an empty bucket returned after %v, want about 100ms", d)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if err := l.Wait(ctx, "1"); err != context.DeadlineExceeded {
+		t.Errorf("Wait err = %v, want the context deadline", err)
+	}
+}
+
+func TestRateLimiterDailyQuota(t *testing.T) {
+	var l = NewRateLimiter(100, 2)
+	kolkata := time.FixedZone("IST", 5*3600+1800)
+	l.SetLocation("1", kolkata)
+
+	var now = time.Date(2024, 3, 1, 23, 0, 0, 0, kolkata)
+	l.bucket("1", now).dayCount = 2
+	var midnight = time.Date(2024, 3, 2, 0, 0, 0, 0, kolkata)
+	if got := l.bucket("1", now).dayEnd; !got.Equal(midnight) {
+		t.Errorf("dayEnd = %v, want %v", got, midnight)
+	}
+	if got := l.bucket("1", midnight.Add(-time.Second)).dayCount; got != 2 {
+		t.Errorf("dayCount before midnight = %d, want 2", got)
+	}
+	b := l.bucket("1", midnight)
+	if b.dayCount != 0 || !b.dayEnd.Equal(midnight.AddDate(0, 0, 1)) {
+		t.Errorf("bucket after midnight = %+v, want the quota renewed", b)
+	}
+}
+
+func TestRateLimiterStats(t *testing.T) {
+	var l = NewRateLimiter(100, 1000)
+	stats := l.Stats("unknown")
+	if stats.Remaining != 100 || stats.RemainingToday != 1000 {
+		t.Errorf("stats of unknown org = %+v, want the full budget", stats)
+	}
+	if stats.DayResetAt.Hour() != 0 || stats.DayResetAt.Location() != time.UTC {
+		t.Errorf("DayResetAt = %v, want midnight UTC", stats.DayResetAt)
+	}
+	if len(l.orgs) != 0 {
+		t.Errorf("Stats stored %d buckets, want none", len(l.orgs))
+	}
+
+	if err := l.Wait(context.Background(), "1"); err != nil {
+		t.Fatal(err)
+	}
+	stats = l.Stats("1")
+	if stats.Remaining != 99 || stats.RemainingToday != 999 {
+		t.Errorf("stats after a request = %+v, want 99 and 999", stats)
+	}
+}
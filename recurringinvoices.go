@@ -0,0 +1,287 @@
+package zohobooks
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+)
+
+// RecurrenceFrequency is the unit of time after which a recurring invoice
+// generates a new invoice
+type RecurrenceFrequency string
+
+// FrequencyDaily generates an invoice every RepeatEvery days
+const FrequencyDaily RecurrenceFrequency = "days"
+
+// FrequencyWeekly generates an invoice every RepeatEvery weeks
+const FrequencyWeekly RecurrenceFrequency = "weeks"
+
+// FrequencyMonthly generates an invoice every RepeatEvery months
+const FrequencyMonthly RecurrenceFrequency = "months"
+
+// FrequencyYearly generates an invoice every RepeatEvery years
+const FrequencyYearly RecurrenceFrequency = "years"
+
+// RecurringInvoice struct represents the information of a recurring invoice
+type RecurringInvoice struct {
+	ID             string   `json:"recurring_invoice_id"`
+	RecurrenceName string   `json:"recurrence_name"`
+	CustomerID     string   `json:"customer_id"`
+	CustomerName   string   `json:"customer_name"`
+	ContactPersons []string `json:"contact_persons"`
+	PlaceOfSupply  string   `json:"place_of_supply"`
+
+	// possible values ---> vat_registered,vat_not_registered,gcc_vat_not_registered,gcc_vat_registered,non_gcc,dz_vat_registered and dz_vat_not_registered.
+	TaxTreatment string `json:"tax_treatment"`
+	GstNO        string `json:"gst_no"`        // 15 digit
+	GstTreatment string `json:"gst_treatment"` // Allowed values are business_gst , business_none , overseas , consumer
+
+	Status          string              `json:"status"` // active, stopped or expired
+	Frequency       RecurrenceFrequency `json:"recurrence_frequency"`
+	RepeatEvery     int                 `json:"repeat_every"`
+	StartDate       string              `json:"start_date"`
+	EndDate         string              `json:"end_date"`
+	NextInvoiceDate string              `json:"next_invoice_date"`
+	LastSentDate    string              `json:"last_sent_date"`
+
+	PaymentTerms      int        `json:"payment_terms"`
+	PaymentTermsLabel string     `json:"payment_terms_label"`
+	CurrencyCode      string     `json:"currency_code"`
+	CurrencyID        string     `json:"currency_id"`
+	ExchangeRate      float64    `json:"exchange_rate"`
+	Discount          float64    `json:"discount"`
+	IsInclusiveTax    bool       `json:"is_inclusive_tax"`
+	RefNo             string     `json:"reference_number"`
+	LineItems         []LineItem `json:"line_items"`
+	Notes             string     `json:"notes"`
+	Terms             string     `json:"terms"`
+	BranchID          string     `json:"branch_id"`
+
+	SubTotal float64   `json:"sub_total"`
+	TaxTotal float64   `json:"tax_total"`
+	Total    float64   `json:"total"`
+	Taxes    []taxInfo `json:"taxes"`
+
+	CreatedTime      string `json:"created_time"`
+	LastModifiedTime string `json:"last_modified_time"`
+
+	BillingAddress  BillingAddress `json:"billing_address"`
+	ShippingAddress BillingAddress `json:"shipping_address"`
+}
+
+// RecurringInvoiceParams struct represents the information to create a recurring invoice
+type RecurringInvoiceParams struct {
+	RecurrenceName string   `json:"recurrence_name"`
+	CustomerID     string   `json:"customer_id"`
+	ContactPersons []string `json:"contact_persons,omitempty"`
+	ReferenceNo    string   `json:"reference_number,omitempty"`
+	PlaceOfSupply  string   `json:"place_of_supply,omitempty"`
+
+	// possible values ---> vat_registered,vat_not_registered,gcc_vat_not_registered,gcc_vat_registered,non_gcc,dz_vat_registered and dz_vat_not_registered.
+	TaxTreatment string `json:"tax_treatment,omitempty"`
+	GstNO        string `json:"gst_no,omitempty"`        // 15 digit
+	GstTreatment string `json:"gst_treatment,omitempty"` // Allowed values are business_gst , business_none , overseas , consumer
+
+	Frequency   RecurrenceFrequency `json:"recurrence_frequency"`
+	RepeatEvery int                 `json:"repeat_every"`
+	StartDate   string              `json:"start_date,omitempty"`
+	EndDate     string              `json:"end_date,omitempty"`
+
+	PaymentTerms      int        `json:"payment_terms,omitempty"`
+	PaymentTermsLabel string     `json:"payment_terms_label,omitempty"`
+	ExchangeRate      float64    `json:"exchange_rate,omitempty"`
+	IsInclusiveTax    bool       `json:"is_inclusive_tax"`
+	Discount          float64    `json:"discount,omitempty"`
+	LineItems         []LineItem `json:"line_items"`
+	Notes             string     `json:"notes,omitempty"`
+	Terms             string     `json:"terms,omitempty"`
+	BranchID          string     `json:"branch_id,omitempty"`
+}
+
+// RecurringInvoiceFindOptions struct contains the filters used to list recurring invoices
+type RecurringInvoiceFindOptions struct {
+	CustomerID     string
+	RecurrenceName string
+	Status         string
+	ListOptions
+}
+
+// Values encodes the options as query parameters
+func (o *RecurringInvoiceFindOptions) Values() url.Values {
+	if o == nil {
+		return url.Values{}
+	}
+	var v = o.ListOptions.Values()
+	setString(v, "customer_id", o.CustomerID)
+	setString(v, "recurrence_name", o.RecurrenceName)
+	setString(v, "status", o.Status)
+	return v
+}
+
+// New method will create a recurring invoice object and return a pointer to it
+func (ri *RecurringInvoice) New() Resource {
+	var obj = &RecurringInvoice{}
+	return obj
+}
+
+// Endpoint method returns the endpoint of the resource
+func (ri *RecurringInvoice) Endpoint() string {
+	return "/recurringinvoices"
+}
+
+// Create method will try to create a recurring invoice on zohobooks
+func (ri *RecurringInvoice) Create(params *RecurringInvoiceParams, client *Client) (*RecurringInvoice, error) {
+	return ri.CreateWithContext(context.Background(), params, client)
+}
+
+// CreateWithContext will try to create a recurring invoice using the given context
+func (ri *RecurringInvoice) CreateWithContext(ctx context.Context, params *RecurringInvoiceParams, client *Client) (*RecurringInvoice, error) {
+	var body, _ = json.Marshal(params)
+	resp, err := client.PostWithContext(ctx, ri.Endpoint(), string(body))
+
+	respData, err := SendResp(resp, err, ri)
+	if err != nil {
+		return ri, err
+	}
+	return &respData.RecurringInvoice, err
+}
+
+// FindOne tries to find the recurring invoice with given id
+func (ri *RecurringInvoice) FindOne(id string, client *Client) (*RecurringInvoice, error) {
+	return ri.FindOneWithContext(context.Background(), id, client)
+}
+
+// FindOneWithContext tries to find the recurring invoice with given id using the given context
+func (ri *RecurringInvoice) FindOneWithContext(ctx context.Context, id string, client *Client) (*RecurringInvoice, error) {
+	resp, err := client.GetWithContext(ctx, ri.Endpoint()+"/"+id)
+	respData, err := SendResp(resp, err, ri)
+	if err != nil {
+		return ri, err
+	}
+	return &respData.RecurringInvoice, err
+}
+
+// FindAll tries to find the recurring invoices with given options
+func (ri *RecurringInvoice) FindAll(opts *RecurringInvoiceFindOptions, client *Client) ([]RecurringInvoice, error) {
+	return ri.FindAllWithContext(context.Background(), opts, client)
+}
+
+// FindAllWithContext tries to find the recurring invoices with given options using the given context
+func (ri *RecurringInvoice) FindAllWithContext(ctx context.Context, opts *RecurringInvoiceFindOptions, client *Client) ([]RecurringInvoice, error) {
+	resp, err := client.GetWithContext(ctx, withQuery(ri.Endpoint(), opts))
+	respData, err := SendResp(resp, err, ri)
+
+	var results []RecurringInvoice
+	if err != nil {
+		return results, err
+	}
+	for _, inv := range respData.RecurringInvoices {
+		results = append(results, inv)
+	}
+	return results, err
+}
+
+// Iterator returns an iterator over all the pages of recurring invoices with given options
+func (ri *RecurringInvoice) Iterator(opts *RecurringInvoiceFindOptions, client *Client) *Iterator[RecurringInvoice] {
+	return NewIterator(func(ctx context.Context, page, perPage int) ([]RecurringInvoice, *PageContext, error) {
+		resp, err := client.GetWithContext(ctx, withPage(ri.Endpoint(), opts, page, perPage))
+		respData, err := SendResp(resp, err, ri)
+		if err != nil {
+			return nil, nil, err
+		}
+		return respData.RecurringInvoices, &respData.PageContext, nil
+	})
+}
+
+// Update method will try to update a recurring invoice on zohobooks
+func (ri *RecurringInvoice) Update(id string, params *RecurringInvoiceParams, client *Client) (*RecurringInvoice, error) {
+	return ri.UpdateWithContext(context.Background(), id, params, client)
+}
+
+// UpdateWithContext will try to update a recurring invoice using the given context
+func (ri *RecurringInvoice) UpdateWithContext(ctx context.Context, id string, params *RecurringInvoiceParams, client *Client) (*RecurringInvoice, error) {
+	var body, _ = json.Marshal(params)
+	resp, err := client.PutWithContext(ctx, ri.Endpoint()+"/"+id, string(body))
+
+	respData, err := SendResp(resp, err, ri)
+	if err != nil {
+		return ri, err
+	}
+	return &respData.RecurringInvoice, err
+}
+
+// Delete tries to delete the recurring invoice with given id
+func (ri *RecurringInvoice) Delete(id string, client *Client) error {
+	return ri.DeleteWithContext(context.Background(), id, client)
+}
+
+// DeleteWithContext tries to delete the recurring invoice with given id using the given context
+func (ri *RecurringInvoice) DeleteWithContext(ctx context.Context, id string, client *Client) error {
+	resp, err := client.DeleteWithContext(ctx, ri.Endpoint()+"/"+id)
+	_, err = SendResp(resp, err, ri)
+	return err
+}
+
+// Stop stops the recurring invoice with given id from generating invoices
+func (ri *RecurringInvoice) Stop(id string, client *Client) error {
+	return ri.StopWithContext(context.Background(), id, client)
+}
+
+// StopWithContext stops the recurring invoice with given id using the given context
+func (ri *RecurringInvoice) StopWithContext(ctx context.Context, id string, client *Client) error {
+	resp, err := client.postAction(ctx, ri.Endpoint()+"/"+id+"/status/stop")
+	_, err = SendResp(resp, err, ri)
+	return err
+}
+
+// Resume resumes the stopped recurring invoice with given id
+func (ri *RecurringInvoice) Resume(id string, client *Client) error {
+	return ri.ResumeWithContext(context.Background(), id, client)
+}
+
+// ResumeWithContext resumes the stopped recurring invoice with given id using the given context
+func (ri *RecurringInvoice) ResumeWithContext(ctx context.Context, id string, client *Client) error {
+	resp, err := client.postAction(ctx, ri.Endpoint()+"/"+id+"/status/resume")
+	_, err = SendResp(resp, err, ri)
+	return err
+}
+
+func (ri *RecurringInvoice) childInvoicesEndpoint(id string) string {
+	var query = url.Values{}
+	query.Set("recurring_invoice_id", id)
+	return appendQuery((&Invoice{}).Endpoint(), query)
+}
+
+// FindChildInvoices returns the invoices generated by the recurring invoice with given id
+func (ri *RecurringInvoice) FindChildInvoices(id string, client *Client) ([]Invoice, error) {
+	return ri.FindChildInvoicesWithContext(context.Background(), id, client)
+}
+
+// FindChildInvoicesWithContext returns the invoices generated by the recurring
+// invoice with given id using the given context
+func (ri *RecurringInvoice) FindChildInvoicesWithContext(ctx context.Context, id string, client *Client) ([]Invoice, error) {
+	resp, err := client.GetWithContext(ctx, ri.childInvoicesEndpoint(id))
+	respData, err := SendResp(resp, err, ri)
+
+	var results []Invoice
+	if err != nil {
+		return results, err
+	}
+	for _, inv := range respData.Invoices {
+		results = append(results, inv)
+	}
+	return results, err
+}
+
+// ChildInvoiceIterator returns an iterator over all the pages of invoices
+// generated by the recurring invoice with given id
+func (ri *RecurringInvoice) ChildInvoiceIterator(id string, client *Client) *Iterator[Invoice] {
+	return NewIterator(func(ctx context.Context, page, perPage int) ([]Invoice, *PageContext, error) {
+		resp, err := client.GetWithContext(ctx, withPage(ri.childInvoicesEndpoint(id), nil, page, perPage))
+		respData, err := SendResp(resp, err, ri)
+		if err != nil {
+			return nil, nil, err
+		}
+		return respData.Invoices, &respData.PageContext, nil
+	})
+}
@@ -0,0 +1,338 @@
+package zohobooks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/url"
+)
+
+// RetainerInvoice struct represents the information of a retainer invoice
+// raised to collect an advance payment
+type RetainerInvoice struct {
+	ID                    string   `json:"retainerinvoice_id"`
+	RetainerInvoiceNumber string   `json:"retainerinvoice_number"`
+	CustomerID            string   `json:"customer_id"`
+	CustomerName          string   `json:"customer_name"`
+	ContactPersons        []string `json:"contact_persons"`
+	PlaceOfSupply         string   `json:"place_of_supply"`
+
+	Status       string  `json:"status"` // draft, sent, paid, partially_drawn, drawn or void
+	Date         string  `json:"date"`
+	CurrencyCode string  `json:"currency_code"`
+	CurrencyID   string  `json:"currency_id"`
+	ExchangeRate float64 `json:"exchange_rate"`
+	// PricePrecision is the number of decimal places of the currency
+	PricePrecision int        `json:"price_precision"`
+	RefNo          string     `json:"reference_number"`
+	ProjectID      string     `json:"project_id"`
+	LineItems      []LineItem `json:"line_items"`
+	Notes          string     `json:"notes"`
+	Terms          string     `json:"terms"`
+
+	Total       float64 `json:"total"`
+	PaymentMade float64 `json:"payment_made"`
+	Balance     float64 `json:"balance"`
+
+	CreatedTime      string `json:"created_time"`
+	LastModifiedTime string `json:"last_modified_time"`
+
+	BillingAddress  BillingAddress `json:"billing_address"`
+	ShippingAddress BillingAddress `json:"shipping_address"`
+
+	Payments []RetainerPayment `json:"payments"`
+}
+
+// RetainerPayment struct represents a payment received for a retainer invoice,
+// it is the payment that is applied to invoices when the retainer is drawn
+type RetainerPayment struct {
+	PaymentID     string  `json:"payment_id"`
+	PaymentNumber string  `json:"payment_number"`
+	Date          string  `json:"date"`
+	Amount        float64 `json:"amount"`
+	PaymentMode   string  `json:"payment_mode"`
+}
+
+// RetainerInvoiceParams struct represents the information to create a retainer invoice
+type RetainerInvoiceParams struct {
+	CustomerID            string     `json:"customer_id"`
+	ContactPersons        []string   `json:"contact_persons,omitempty"`
+	RetainerInvoiceNumber string     `json:"retainerinvoice_number,omitempty"`
+	ReferenceNo           string     `json:"reference_number,omitempty"`
+	PlaceOfSupply         string     `json:"place_of_supply,omitempty"`
+	Date                  string     `json:"date,omitempty"`
+	ExchangeRate          float64    `json:"exchange_rate,omitempty"`
+	ProjectID             string     `json:"project_id,omitempty"`
+	LineItems             []LineItem `json:"line_items"`
+	Notes                 string     `json:"notes,omitempty"`
+	Terms                 string     `json:"terms,omitempty"`
+}
+
+// RetainerInvoiceFindOptions struct contains the filters used to list retainer invoices
+type RetainerInvoiceFindOptions struct {
+	CustomerID string
+	Status     string
+	ListOptions
+}
+
+// Values encodes the options as query parameters
+func (o *RetainerInvoiceFindOptions) Values() url.Values {
+	if o == nil {
+		return url.Values{}
+	}
+	var v = o.ListOptions.Values()
+	setString(v, "customer_id", o.CustomerID)
+	setString(v, "status", o.Status)
+	return v
+}
+
+// invoicePaymentCredit is the amount of an existing payment applied to an invoice
+type invoicePaymentCredit struct {
+	PaymentID     string  `json:"payment_id"`
+	AmountApplied float64 `json:"amount_applied"`
+}
+
+// paymentBalance is the amount of a payment not yet applied to invoices
+type paymentBalance struct {
+	PaymentID string
+	Unused    float64
+}
+
+// defaultPricePrecision is used when zohobooks does not return the precision
+// of the currency, integer amounts are exact at this precision too
+const defaultPricePrecision = 2
+
+// toMinorUnits converts the amount to an integer count of the smallest unit
+// of a currency with the given precision, e.g. cents for a precision of 2
+func toMinorUnits(amount float64, precision int) int64 {
+	return int64(math.Round(amount * math.Pow10(precision)))
+}
+
+func fromMinorUnits(units int64, precision int) float64 {
+	return float64(units) / math.Pow10(precision)
+}
+
+// splitPaymentCredits draws amount from the unused balances of the payments
+// in order. The amounts are split in minor units so that the credits add up
+// to amount exactly, ok is false when the balances do not cover it
+func splitPaymentCredits(balances []paymentBalance, amount float64, precision int) (credits []invoicePaymentCredit, ok bool) {
+	var remaining = toMinorUnits(amount, precision)
+	for _, b := range balances {
+		if remaining <= 0 {
+			break
+		}
+		var applied = toMinorUnits(b.Unused, precision)
+		if applied <= 0 {
+			continue
+		}
+		if applied > remaining {
+			applied = remaining
+		}
+		credits = append(credits, invoicePaymentCredit{PaymentID: b.PaymentID, AmountApplied: fromMinorUnits(applied, precision)})
+		remaining -= applied
+	}
+	return credits, remaining <= 0
+}
+
+// invoicePaymentCredits is the body of POST /invoices/{id}/credits applying
+// payments, see https://www.zoho.com/books/api/v3/invoices/#apply-credits
+type invoicePaymentCredits struct {
+	InvoicePayments []invoicePaymentCredit `json:"invoice_payments"`
+}
+
+// New method will create a retainer invoice object and return a pointer to it
+func (ri *RetainerInvoice) New() Resource {
+	var obj = &RetainerInvoice{}
+	return obj
+}
+
+// Endpoint method returns the endpoint of the resource
+func (ri *RetainerInvoice) Endpoint() string {
+	return "/retainerinvoices"
+}
+
+// Create method will try to create a retainer invoice on zohobooks
+func (ri *RetainerInvoice) Create(params *RetainerInvoiceParams, client *Client) (*RetainerInvoice, error) {
+	return ri.CreateWithContext(context.Background(), params, client)
+}
+
+// CreateWithContext will try to create a retainer invoice using the given context
+func (ri *RetainerInvoice) CreateWithContext(ctx context.Context, params *RetainerInvoiceParams, client *Client) (*RetainerInvoice, error) {
+	var body, _ = json.Marshal(params)
+	resp, err := client.PostWithContext(ctx, ri.Endpoint(), string(body))
+
+	respData, err := SendResp(resp, err, ri)
+	if err != nil {
+		return ri, err
+	}
+	return &respData.RetainerInvoice, err
+}
+
+// FindOne tries to find the retainer invoice with given id
+func (ri *RetainerInvoice) FindOne(id string, client *Client) (*RetainerInvoice, error) {
+	return ri.FindOneWithContext(context.Background(), id, client)
+}
+
+// FindOneWithContext tries to find the retainer invoice with given id using the given context
+func (ri *RetainerInvoice) FindOneWithContext(ctx context.Context, id string, client *Client) (*RetainerInvoice, error) {
+	resp, err := client.GetWithContext(ctx, ri.Endpoint()+"/"+id)
+	respData, err := SendResp(resp, err, ri)
+	if err != nil {
+		return ri, err
+	}
+	return &respData.RetainerInvoice, err
+}
+
+// FindAll tries to find the retainer invoices with given options
+func (ri *RetainerInvoice) FindAll(opts *RetainerInvoiceFindOptions, client *Client) ([]RetainerInvoice, error) {
+	return ri.FindAllWithContext(context.Background(), opts, client)
+}
+
+// FindAllWithContext tries to find the retainer invoices with given options using the given context
+func (ri *RetainerInvoice) FindAllWithContext(ctx context.Context, opts *RetainerInvoiceFindOptions, client *Client) ([]RetainerInvoice, error) {
+	resp, err := client.GetWithContext(ctx, withQuery(ri.Endpoint(), opts))
+	respData, err := SendResp(resp, err, ri)
+
+	var results []RetainerInvoice
+	if err != nil {
+		return results, err
+	}
+	for _, inv := range respData.RetainerInvoices {
+		results = append(results, inv)
+	}
+	return results, err
+}
+
+// Iterator returns an iterator over all the pages of retainer invoices with given options
+func (ri *RetainerInvoice) Iterator(opts *RetainerInvoiceFindOptions, client *Client) *Iterator[RetainerInvoice] {
+	return NewIterator(func(ctx context.Context, page, perPage int) ([]RetainerInvoice, *PageContext, error) {
+		resp, err := client.GetWithContext(ctx, withPage(ri.Endpoint(), opts, page, perPage))
+		respData, err := SendResp(resp, err, ri)
+		if err != nil {
+			return nil, nil, err
+		}
+		return respData.RetainerInvoices, &respData.PageContext, nil
+	})
+}
+
+// Update method will try to update a retainer invoice on zohobooks
+func (ri *RetainerInvoice) Update(id string, params *RetainerInvoiceParams, client *Client) (*RetainerInvoice, error) {
+	return ri.UpdateWithContext(context.Background(), id, params, client)
+}
+
+// UpdateWithContext will try to update a retainer invoice using the given context
+func (ri *RetainerInvoice) UpdateWithContext(ctx context.Context, id string, params *RetainerInvoiceParams, client *Client) (*RetainerInvoice, error) {
+	var body, _ = json.Marshal(params)
+	resp, err := client.PutWithContext(ctx, ri.Endpoint()+"/"+id, string(body))
+
+	respData, err := SendResp(resp, err, ri)
+	if err != nil {
+		return ri, err
+	}
+	return &respData.RetainerInvoice, err
+}
+
+// Delete tries to delete the retainer invoice with given id
+func (ri *RetainerInvoice) Delete(id string, client *Client) error {
+	return ri.DeleteWithContext(context.Background(), id, client)
+}
+
+// DeleteWithContext tries to delete the retainer invoice with given id using the given context
+func (ri *RetainerInvoice) DeleteWithContext(ctx context.Context, id string, client *Client) error {
+	resp, err := client.DeleteWithContext(ctx, ri.Endpoint()+"/"+id)
+	_, err = SendResp(resp, err, ri)
+	return err
+}
+
+// MarkSent marks the retainer invoice with given id as sent
+func (ri *RetainerInvoice) MarkSent(id string, client *Client) error {
+	return ri.MarkSentWithContext(context.Background(), id, client)
+}
+
+// MarkSentWithContext marks the retainer invoice with given id as sent using the given context
+func (ri *RetainerInvoice) MarkSentWithContext(ctx context.Context, id string, client *Client) error {
+	resp, err := client.postAction(ctx, ri.Endpoint()+"/"+id+"/status/sent")
+	_, err = SendResp(resp, err, ri)
+	return err
+}
+
+// Void marks the retainer invoice with given id as void
+func (ri *RetainerInvoice) Void(id string, client *Client) error {
+	return ri.VoidWithContext(context.Background(), id, client)
+}
+
+// VoidWithContext marks the retainer invoice with given id as void using the given context
+func (ri *RetainerInvoice) VoidWithContext(ctx context.Context, id string, client *Client) error {
+	resp, err := client.postAction(ctx, ri.Endpoint()+"/"+id+"/status/void")
+	_, err = SendResp(resp, err, ri)
+	return err
+}
+
+// Email method will send the retainer invoice to the customer
+func (ri *RetainerInvoice) Email(id string, params *EmailParams, client *Client) error {
+	return ri.EmailWithContext(context.Background(), id, params, client)
+}
+
+// EmailWithContext will send the retainer invoice to the customer using the given context
+func (ri *RetainerInvoice) EmailWithContext(ctx context.Context, id string, params *EmailParams, client *Client) error {
+	var body, _ = json.Marshal(params)
+	resp, err := client.PostWithContext(ctx, ri.Endpoint()+"/"+id+"/email", string(body))
+
+	_, err = SendResp(resp, err, ri)
+	return err
+}
+
+// DownloadPDF method will download the pdf to the given filepath
+func (ri *RetainerInvoice) DownloadPDF(id, filepath string, client *Client) error {
+	return ri.DownloadPDFWithContext(context.Background(), id, filepath, client)
+}
+
+// DownloadPDFWithContext will download the pdf to the given filepath using the given context
+func (ri *RetainerInvoice) DownloadPDFWithContext(ctx context.Context, id, filepath string, client *Client) error {
+	return client.download(ctx, appendQuery(ri.Endpoint()+"/"+id, url.Values{"accept": {"pdf"}}), filepath)
+}
+
+// ApplyToInvoice applies amount of the paid retainer invoice with given id
+// as a credit against the invoice, the amount is drawn from the unused
+// balance of the payments of the retainer in the order they were received
+func (ri *RetainerInvoice) ApplyToInvoice(id, invoiceID string, amount float64, client *Client) error {
+	return ri.ApplyToInvoiceWithContext(context.Background(), id, invoiceID, amount, client)
+}
+
+// ApplyToInvoiceWithContext applies amount of the paid retainer invoice with
+// given id as a credit against the invoice using the given context
+func (ri *RetainerInvoice) ApplyToInvoiceWithContext(ctx context.Context, id, invoiceID string, amount float64, client *Client) error {
+	retainer, err := ri.FindOneWithContext(ctx, id, client)
+	if err != nil {
+		return err
+	}
+	// the retainer only lists the amount received, the part of each payment
+	// left after the earlier draws is read from the payment itself
+	var balances []paymentBalance
+	var unused float64
+	for _, rp := range retainer.Payments {
+		payment, err := (&Payment{}).FindOneWithContext(ctx, rp.PaymentID, client)
+		if err != nil {
+			return err
+		}
+		balances = append(balances, paymentBalance{PaymentID: rp.PaymentID, Unused: payment.UnusedAmount})
+		unused += payment.UnusedAmount
+	}
+	var precision = retainer.PricePrecision
+	if precision == 0 {
+		precision = defaultPricePrecision
+	}
+	credits, ok := splitPaymentCredits(balances, amount, precision)
+	if !ok {
+		return fmt.Errorf("zohobooks: retainer invoice %s has %s unused, cannot apply %s", id,
+			formatFloat(fromMinorUnits(toMinorUnits(unused, precision), precision)), formatFloat(amount))
+	}
+
+	var inv = &Invoice{}
+	var body, _ = json.Marshal(&invoicePaymentCredits{InvoicePayments: credits})
+	resp, err := client.PostWithContext(ctx, inv.Endpoint()+"/"+invoiceID+"/credits", string(body))
+
+	_, err = SendResp(resp, err, inv)
+	return err
+}
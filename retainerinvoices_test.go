@@ -0,0 +1,90 @@
+package zohobooks
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestSplitPaymentCredits(t *testing.T) {
+	var tests = []struct {
+		name     string
+		unused   []float64
+		amount   float64
+		want     []float64
+		covered  bool
+		decimals int
+	}{
+		{"cents adding up to 2.04", []float64{0.01, 2.03}, 2.04, []float64{0.01, 2.03}, true, 2},
+		{"cents adding up to 0.07", []float64{0.01, 0.06}, 0.07, []float64{0.01, 0.06}, true, 2},
+		{"cents adding up to 0.10", []float64{0.01, 0.09}, 0.10, []float64{0.01, 0.09}, true, 2},
+		{"partial draw of the last payment", []float64{0.1, 5}, 2.13, []float64{0.1, 2.03}, true, 2},
+		{"used up payments are skipped", []float64{0, 0.004, 3.3}, 1.1, []float64{1.1}, true, 2},
+		{"not covered", []float64{0.01, 2.02}, 2.04, []float64{0.01, 2.02}, false, 2},
+		{"three decimal currency", []float64{0.001, 1.002}, 1.003, []float64{0.001, 1.002}, true, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var balances []paymentBalance
+			for i, u := range tt.unused {
+				balances = append(balances, paymentBalance{PaymentID: string(rune('a' + i)), Unused: u})
+			}
+			credits, ok := splitPaymentCredits(balances, tt.amount, tt.decimals)
+			if ok != tt.covered {
+				t.Errorf("ok = %v, want %v", ok, tt.covered)
+			}
+			var got []float64
+			for _, c := range credits {
+				got = append(got, c.AmountApplied)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("applied = %v, want %v", got, tt.want)
+			}
+			// the amounts must be sent as written, not as 2.0300000000000002
+			body, _ := json.Marshal(credits)
+			var decoded []invoicePaymentCredit
+			json.Unmarshal(body, &decoded)
+			if !reflect.DeepEqual(decoded, credits) {
+				t.Errorf("body %s does not round trip", body)
+			}
+		})
+	}
+}
+
+func TestApplyToInvoiceUsesUnusedBalance(t *testing.T) {
+	var sent []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/books/v3/retainerinvoices/r1", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, `{"code":0,"retainerinvoice":{"retainerinvoice_id":"r1","status":"partially_drawn",
+			"price_precision":2,"payments":[{"payment_id":"p1","amount":100},{"payment_id":"p2","amount":50.5}]}}`)
+	})
+	// p1 was used up by an earlier draw and p2 has 20.25 of its 50.5 left
+	mux.HandleFunc("/books/v3/customerpayments/p1", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, `{"code":0,"payment":{"payment_id":"p1","amount":100,"unused_amount":0}}`)
+	})
+	mux.HandleFunc("/books/v3/customerpayments/p2", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, `{"code":0,"payment":{"payment_id":"p2","amount":50.5,"unused_amount":20.25}}`)
+	})
+	mux.HandleFunc("/books/v3/invoices/i1/credits", func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Error(err)
+		}
+		sent = append(sent, r.PostForm.Get("JSONString"))
+		writeJSON(w, http.StatusOK, `{"code":0,"message":"Credits have been applied to the invoice(s)."}`)
+	})
+	c := newTestClient(t, mux, testConfig(NewMemoryTokenStore(&Token{AccessToken: "a1"})))
+
+	var ri = &RetainerInvoice{}
+	if err := ri.ApplyToInvoice("r1", "i1", 20.2, c); err != nil {
+		t.Fatal(err)
+	}
+	if err := ri.ApplyToInvoice("r1", "i1", 20.26, c); err == nil {
+		t.Error("applying more than the unused balance succeeded")
+	}
+
+	var want = []string{`{"invoice_payments":[{"payment_id":"p2","amount_applied":20.2}]}`}
+	if !reflect.DeepEqual(sent, want) {
+		t.Errorf("sent %v, want %v", sent, want)
+	}
+}
@@ -0,0 +1,114 @@
+package zohobooks
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy struct configures how requests failing with network errors,
+// 5xx responses or throttling are retried
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts including the first one
+	MaxAttempts int
+	// MinBackoff is the delay before the first retry, it doubles on each attempt
+	MinBackoff time.Duration
+	// MaxBackoff caps the delay between two attempts
+	MaxBackoff time.Duration
+	// Jitter is the fraction of the delay, between 0 and 1, which is randomized
+	Jitter float64
+	// RetryPost allows POST requests to be retried, they are not idempotent
+	// so a retried POST may create a resource twice
+	RetryPost bool
+}
+
+// DefaultRetryPolicy returns a policy making up to 3 attempts of idempotent requests
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 3,
+		MinBackoff:  500 * time.Millisecond,
+		MaxBackoff:  10 * time.Second,
+		Jitter:      0.2,
+	}
+}
+
+func (p *RetryPolicy) allows(method string) bool {
+	switch method {
+	case "GET", "HEAD", "PUT", "DELETE":
+		return true
+	case "POST":
+		return p.RetryPost
+	}
+	return false
+}
+
+// backoff returns the delay before the given retry, starting from 1
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	var delay = p.MinBackoff
+	for i := 1; i < retry && (p.MaxBackoff <= 0 || delay < p.MaxBackoff); i++ {
+		delay *= 2
+	}
+	if p.MaxBackoff > 0 && delay > p.MaxBackoff {
+		delay = p.MaxBackoff
+	}
+	if p.Jitter > 0 {
+		delay -= time.Duration(p.Jitter * rand.Float64() * float64(delay))
+	}
+	return delay
+}
+
+// shouldRetry reports whether the result of an attempt is a transient failure
+func shouldRetry(ctx context.Context, resp *http.Response, err error) bool {
+	if err != nil {
+		return ctx.Err() == nil
+	}
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError
+}
+
+// retryAfter parses the Retry-After header which holds either a number of
+// seconds or a HTTP date
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	var value = resp.Header.Get("Retry-After")
+	if len(value) == 0 {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(value); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// doWithRetry makes the request retrying it as configured by the retry policy
+// of the client
+func (c *Client) doWithRetry(ctx context.Context, method, path string, body []byte, headers map[string]string, token string) (*http.Response, error) {
+	var policy = c.retry
+	for attempt := 1; ; attempt++ {
+		resp, err := c.doRequest(ctx, method, path, body, headers, token)
+		if policy == nil || attempt >= policy.MaxAttempts || !policy.allows(method) || !shouldRetry(ctx, resp, err) {
+			return resp, err
+		}
+
+		var delay = policy.backoff(attempt)
+		if resp != nil {
+			if d, ok := retryAfter(resp); ok {
+				delay = d
+			}
+			resp.Body.Close()
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package zohobooks
+
+import (
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func apiStatus(err error) int {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.HTTPStatus
+	}
+	return 0
+}
+
+func TestRetryBackoff(t *testing.T) {
+	var p = &RetryPolicy{MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+	var want = []time.Duration{
+		100 * time.Millisecond,
+		200 * time.Millisecond,
+		400 * time.Millisecond,
+		800 * time.Millisecond,
+		time.Second,
+		time.Second,
+	}
+	for i, w := range want {
+		if got := p.backoff(i + 1); got != w {
+			t.Errorf("backoff(%d) = %v, want %v", i+1, got, w)
+		}
+	}
+
+	p.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		if got := p.backoff(2); got <= 100*time.Millisecond || got > 200*time.Millisecond {
+			t.Fatalf("backoff(2) with jitter = %v, want within (100ms, 200ms]", got)
+		}
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	var tests = []struct {
+		value string
+		want  time.Duration
+		ok    bool
+	}{
+		{"", 0, false},
+		{"3", 3 * time.Second, true},
+		{"-1", 0, false},
+		{"soon", 0, false},
+		{time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat), 0, true},
+	}
+	for _, tt := range tests {
+		resp := &http.Response{Header: http.Header{}}
+		resp.Header.Set("Retry-After", tt.value)
+		got, ok := retryAfter(resp)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("retryAfter(%q) = %v, %v, want %v, %v", tt.value, got, ok, tt.want, tt.ok)
+		}
+	}
+
+	resp := &http.Response{Header: http.Header{}}
+	resp.Header.Set("Retry-After", time.Now().Add(time.Hour).UTC().Format(http.TimeFormat))
+	if got, ok := retryAfter(resp); !ok || got < 59*time.Minute || got > time.Hour {
+		t.Errorf("retryAfter(date in an hour) = %v, %v, want about an hour", got, ok)
+	}
+}
+
+func TestDoWithRetry(t *testing.T) {
+	var calls int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/books/v3/contacts/1", func(w http.ResponseWriter, r *http.Request) {
+		switch atomic.AddInt32(&calls, 1) {
+		case 1:
+			w.Header().Set("Retry-After", "0")
+			writeJSON(w, http.StatusTooManyRequests, `{"code":44,"message":"too many requests"}`)
+		case 2:
+			writeJSON(w, http.StatusBadGateway, `{}`)
+		default:
+			writeJSON(w, http.StatusOK, `{"code":0,"contact":{"contact_id":"1"}}`)
+		}
+	})
+	mux.HandleFunc("/books/v3/contacts", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		writeJSON(w, http.StatusServiceUnavailable, `{}`)
+	})
+	var conf = testConfig(NewMemoryTokenStore(&Token{AccessToken: "a1"}))
+	conf.Retry = &RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond}
+	c := newTestClient(t, mux, conf)
+
+	if _, err := (&Contact{}).FindOne("1", c); err != nil {
+		t.Fatal(err)
+	}
+	if n := atomic.LoadInt32(&calls); n != 3 {
+		t.Errorf("calls = %d, want 3", n)
+	}
+
+	// POST is not retried unless the policy allows it
+	atomic.StoreInt32(&calls, 0)
+	if _, err := (&Contact{}).Create(&ContactParams{Name: "a"}, c); apiStatus(err) != http.StatusServiceUnavailable {
+		t.Errorf("err = %v, want status 503", err)
+	}
+	if n := atomic.LoadInt32(&calls); n != 1 {
+		t.Errorf("POST calls = %d, want 1", n)
+	}
+
+	atomic.StoreInt32(&calls, 0)
+	c.retry.RetryPost = true
+	(&Contact{}).Create(&ContactParams{Name: "a"}, c)
+	if n := atomic.LoadInt32(&calls); n != 3 {
+		t.Errorf("POST calls with RetryPost = %d, want 3", n)
+	}
+}
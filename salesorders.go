@@ -0,0 +1,277 @@
+package zohobooks
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+)
+
+// SalesOrder struct represents the information of a sales order
+type SalesOrder struct {
+	ID               string   `json:"salesorder_id"`
+	SalesOrderNumber string   `json:"salesorder_number"`
+	CustomerID       string   `json:"customer_id"`
+	CustomerName     string   `json:"customer_name"`
+	ContactPersons   []string `json:"contact_persons"`
+	PlaceOfSupply    string   `json:"place_of_supply"`
+
+	// possible values ---> vat_registered,vat_not_registered,gcc_vat_not_registered,gcc_vat_registered,non_gcc,dz_vat_registered and dz_vat_not_registered.
+	TaxTreatment string `json:"tax_treatment"`
+	GstNO        string `json:"gst_no"`        // 15 digit
+	GstTreatment string `json:"gst_treatment"` // Allowed values are business_gst , business_none , overseas , consumer
+
+	Status         string `json:"status"`
+	OrderStatus    string `json:"order_status"`
+	InvoicedStatus string `json:"invoiced_status"` // invoiced, partially_invoiced or not_invoiced
+	PaidStatus     string `json:"paid_status"`     // paid, partially_paid or unpaid
+	ShippedStatus  string `json:"shipped_status"`  // shipped, partially_shipped or pending
+
+	Date           string     `json:"date"`
+	ShipmentDate   string     `json:"shipment_date"`
+	CurrencyCode   string     `json:"currency_code"`
+	CurrencyID     string     `json:"currency_id"`
+	ExchangeRate   float64    `json:"exchange_rate"`
+	Discount       float64    `json:"discount"`
+	IsInclusiveTax bool       `json:"is_inclusive_tax"`
+	RefNo          string     `json:"reference_number"`
+	LineItems      []LineItem `json:"line_items"`
+	Notes          string     `json:"notes"`
+	Terms          string     `json:"terms"`
+	BranchID       string     `json:"branch_id"`
+
+	SubTotal float64   `json:"sub_total"`
+	TaxTotal float64   `json:"tax_total"`
+	Total    float64   `json:"total"`
+	Taxes    []taxInfo `json:"taxes"`
+
+	CreatedTime      string `json:"created_time"`
+	LastModifiedTime string `json:"last_modified_time"`
+
+	BillingAddress  BillingAddress `json:"billing_address"`
+	ShippingAddress BillingAddress `json:"shipping_address"`
+}
+
+// SalesOrderParams struct represents the information to create a sales order
+type SalesOrderParams struct {
+	CustomerID       string   `json:"customer_id"`
+	ContactPersons   []string `json:"contact_persons,omitempty"`
+	SalesOrderNumber string   `json:"salesorder_number,omitempty"`
+	ReferenceNo      string   `json:"reference_number,omitempty"`
+	PlaceOfSupply    string   `json:"place_of_supply,omitempty"`
+
+	// possible values ---> vat_registered,vat_not_registered,gcc_vat_not_registered,gcc_vat_registered,non_gcc,dz_vat_registered and dz_vat_not_registered.
+	TaxTreatment string `json:"tax_treatment,omitempty"`
+	GstNO        string `json:"gst_no,omitempty"`        // 15 digit
+	GstTreatment string `json:"gst_treatment,omitempty"` // Allowed values are business_gst , business_none , overseas , consumer
+
+	Date           string     `json:"date,omitempty"`
+	ShipmentDate   string     `json:"shipment_date,omitempty"`
+	ExchangeRate   float64    `json:"exchange_rate,omitempty"`
+	IsInclusiveTax bool       `json:"is_inclusive_tax"`
+	Discount       float64    `json:"discount,omitempty"`
+	LineItems      []LineItem `json:"line_items"`
+	Notes          string     `json:"notes,omitempty"`
+	Terms          string     `json:"terms,omitempty"`
+	BranchID       string     `json:"branch_id,omitempty"`
+}
+
+// SalesOrderFindOptions struct contains the filters used to list sales orders
+type SalesOrderFindOptions struct {
+	CustomerID       string
+	SalesOrderNumber string
+	ReferenceNo      string
+	ListOptions
+}
+
+// Values encodes the options as query parameters
+func (o *SalesOrderFindOptions) Values() url.Values {
+	if o == nil {
+		return url.Values{}
+	}
+	var v = o.ListOptions.Values()
+	setString(v, "customer_id", o.CustomerID)
+	setString(v, "salesorder_number", o.SalesOrderNumber)
+	setString(v, "reference_number", o.ReferenceNo)
+	return v
+}
+
+// New method will create a sales order object and return a pointer to it
+func (so *SalesOrder) New() Resource {
+	var obj = &SalesOrder{}
+	return obj
+}
+
+// Endpoint method returns the endpoint of the resource
+func (so *SalesOrder) Endpoint() string {
+	return "/salesorders"
+}
+
+// Create method will try to create a sales order on zohobooks
+func (so *SalesOrder) Create(params *SalesOrderParams, client *Client) (*SalesOrder, error) {
+	return so.CreateWithContext(context.Background(), params, client)
+}
+
+// CreateWithContext will try to create a sales order using the given context
+func (so *SalesOrder) CreateWithContext(ctx context.Context, params *SalesOrderParams, client *Client) (*SalesOrder, error) {
+	var body, _ = json.Marshal(params)
+	resp, err := client.PostWithContext(ctx, so.Endpoint(), string(body))
+
+	respData, err := SendResp(resp, err, so)
+	if err != nil {
+		return so, err
+	}
+	return &respData.SalesOrder, err
+}
+
+// FindOne tries to find the sales order with given id
+func (so *SalesOrder) FindOne(id string, client *Client) (*SalesOrder, error) {
+	return so.FindOneWithContext(context.Background(), id, client)
+}
+
+// FindOneWithContext tries to find the sales order with given id using the given context
+func (so *SalesOrder) FindOneWithContext(ctx context.Context, id string, client *Client) (*SalesOrder, error) {
+	resp, err := client.GetWithContext(ctx, so.Endpoint()+"/"+id)
+	respData, err := SendResp(resp, err, so)
+	if err != nil {
+		return so, err
+	}
+	return &respData.SalesOrder, err
+}
+
+// FindAll tries to find the sales orders with given options
+func (so *SalesOrder) FindAll(opts *SalesOrderFindOptions, client *Client) ([]SalesOrder, error) {
+	return so.FindAllWithContext(context.Background(), opts, client)
+}
+
+// FindAllWithContext tries to find the sales orders with given options using the given context
+func (so *SalesOrder) FindAllWithContext(ctx context.Context, opts *SalesOrderFindOptions, client *Client) ([]SalesOrder, error) {
+	resp, err := client.GetWithContext(ctx, withQuery(so.Endpoint(), opts))
+	respData, err := SendResp(resp, err, so)
+
+	var results []SalesOrder
+	if err != nil {
+		return results, err
+	}
+	for _, order := range respData.SalesOrders {
+		results = append(results, order)
+	}
+	return results, err
+}
+
+// Iterator returns an iterator over all the pages of sales orders with given options
+func (so *SalesOrder) Iterator(opts *SalesOrderFindOptions, client *Client) *Iterator[SalesOrder] {
+	return NewIterator(func(ctx context.Context, page, perPage int) ([]SalesOrder, *PageContext, error) {
+		resp, err := client.GetWithContext(ctx, withPage(so.Endpoint(), opts, page, perPage))
+		respData, err := SendResp(resp, err, so)
+		if err != nil {
+			return nil, nil, err
+		}
+		return respData.SalesOrders, &respData.PageContext, nil
+	})
+}
+
+// Update method will try to update a sales order on zohobooks
+func (so *SalesOrder) Update(id string, params *SalesOrderParams, client *Client) (*SalesOrder, error) {
+	return so.UpdateWithContext(context.Background(), id, params, client)
+}
+
+// UpdateWithContext will try to update a sales order using the given context
+func (so *SalesOrder) UpdateWithContext(ctx context.Context, id string, params *SalesOrderParams, client *Client) (*SalesOrder, error) {
+	var body, _ = json.Marshal(params)
+	resp, err := client.PutWithContext(ctx, so.Endpoint()+"/"+id, string(body))
+
+	respData, err := SendResp(resp, err, so)
+	if err != nil {
+		return so, err
+	}
+	return &respData.SalesOrder, err
+}
+
+// Delete tries to delete the sales order with given id
+func (so *SalesOrder) Delete(id string, client *Client) error {
+	return so.DeleteWithContext(context.Background(), id, client)
+}
+
+// DeleteWithContext tries to delete the sales order with given id using the given context
+func (so *SalesOrder) DeleteWithContext(ctx context.Context, id string, client *Client) error {
+	resp, err := client.DeleteWithContext(ctx, so.Endpoint()+"/"+id)
+	_, err = SendResp(resp, err, so)
+	return err
+}
+
+// MarkOpen marks the sales order with given id as open
+func (so *SalesOrder) MarkOpen(id string, client *Client) error {
+	return so.MarkOpenWithContext(context.Background(), id, client)
+}
+
+// MarkOpenWithContext marks the sales order with given id as open using the given context
+func (so *SalesOrder) MarkOpenWithContext(ctx context.Context, id string, client *Client) error {
+	resp, err := client.postAction(ctx, so.Endpoint()+"/"+id+"/status/open")
+	_, err = SendResp(resp, err, so)
+	return err
+}
+
+// MarkConfirmed marks the sales order with given id as confirmed
+func (so *SalesOrder) MarkConfirmed(id string, client *Client) error {
+	return so.MarkConfirmedWithContext(context.Background(), id, client)
+}
+
+// MarkConfirmedWithContext marks the sales order with given id as confirmed using the given context
+func (so *SalesOrder) MarkConfirmedWithContext(ctx context.Context, id string, client *Client) error {
+	resp, err := client.postAction(ctx, so.Endpoint()+"/"+id+"/status/confirmed")
+	_, err = SendResp(resp, err, so)
+	return err
+}
+
+// Void marks the sales order with given id as void
+func (so *SalesOrder) Void(id string, client *Client) error {
+	return so.VoidWithContext(context.Background(), id, client)
+}
+
+// VoidWithContext marks the sales order with given id as void using the given context
+func (so *SalesOrder) VoidWithContext(ctx context.Context, id string, client *Client) error {
+	resp, err := client.postAction(ctx, so.Endpoint()+"/"+id+"/status/void")
+	_, err = SendResp(resp, err, so)
+	return err
+}
+
+// Email method will send the sales order to the customer
+func (so *SalesOrder) Email(id string, params *EmailParams, client *Client) error {
+	return so.EmailWithContext(context.Background(), id, params, client)
+}
+
+// EmailWithContext will send the sales order to the customer using the given context
+func (so *SalesOrder) EmailWithContext(ctx context.Context, id string, params *EmailParams, client *Client) error {
+	var body, _ = json.Marshal(params)
+	resp, err := client.PostWithContext(ctx, so.Endpoint()+"/"+id+"/email", string(body))
+
+	_, err = SendResp(resp, err, so)
+	return err
+}
+
+// DownloadPDF method will download the pdf to the given filepath
+func (so *SalesOrder) DownloadPDF(id, filepath string, client *Client) error {
+	return so.DownloadPDFWithContext(context.Background(), id, filepath, client)
+}
+
+// DownloadPDFWithContext will download the pdf to the given filepath using the given context
+func (so *SalesOrder) DownloadPDFWithContext(ctx context.Context, id, filepath string, client *Client) error {
+	return client.download(ctx, appendQuery(so.Endpoint()+"/pdf", url.Values{"salesorder_ids": {id}}), filepath)
+}
+
+// ConvertToInvoice creates an invoice from the sales order with given id
+func (so *SalesOrder) ConvertToInvoice(id string, client *Client) (*Invoice, error) {
+	return so.ConvertToInvoiceWithContext(context.Background(), id, client)
+}
+
+// ConvertToInvoiceWithContext creates an invoice from the sales order with
+// given id using the given context
+func (so *SalesOrder) ConvertToInvoiceWithContext(ctx context.Context, id string, client *Client) (*Invoice, error) {
+	var inv = &Invoice{}
+	resp, err := client.postAction(ctx, appendQuery(inv.Endpoint()+"/fromsalesorder", url.Values{"salesorder_id": {id}}))
+	respData, err := SendResp(resp, err, inv)
+	if err != nil {
+		return nil, err
+	}
+	return &respData.Invoice, err
+}
@@ -0,0 +1,490 @@
+package zohobooks
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+)
+
+// ErrTaxNotFound is returned by the tax lookups when no tax matches, it
+// matches ErrNotFound with errors.Is
+var ErrTaxNotFound error = &notFoundError{"zohobooks: tax not found"}
+
+// Tax struct represents the information of a tax
+type Tax struct {
+	ID            string  `json:"tax_id"`
+	Name          string  `json:"tax_name"`
+	Percentage    float64 `json:"tax_percentage"`
+	Type          string  `json:"tax_type"`          // tax, compound_tax or tax_group
+	SpecificType  string  `json:"tax_specific_type"` // e.g. igst, cgst, sgst
+	AuthorityID   string  `json:"tax_authority_id"`
+	AuthorityName string  `json:"tax_authority_name"`
+	IsValueAdded  bool    `json:"is_value_added"`
+	IsDefaultTax  bool    `json:"is_default_tax"`
+	IsEditable    bool    `json:"is_editable"`
+	Status        string  `json:"status"`
+}
+
+// TaxParams struct represents the information to create a tax
+type TaxParams struct {
+	Name          string  `json:"tax_name"`
+	Percentage    float64 `json:"tax_percentage"`
+	Type          string  `json:"tax_type,omitempty"`
+	SpecificType  string  `json:"tax_specific_type,omitempty"`
+	AuthorityID   string  `json:"tax_authority_id,omitempty"`
+	AuthorityName string  `json:"tax_authority_name,omitempty"`
+	IsValueAdded  bool    `json:"is_value_added,omitempty"`
+}
+
+// New method will create a tax object and return a pointer to it
+func (t *Tax) New() Resource {
+	var obj = &Tax{}
+	return obj
+}
+
+// Endpoint method returns the endpoint of the resource
+func (t *Tax) Endpoint() string {
+	return "/settings/taxes"
+}
+
+// Create method will try to create a tax on zohobooks
+func (t *Tax) Create(params *TaxParams, client *Client) (*Tax, error) {
+	return t.CreateWithContext(context.Background(), params, client)
+}
+
+// CreateWithContext will try to create a tax using the given context
+func (t *Tax) CreateWithContext(ctx context.Context, params *TaxParams, client *Client) (*Tax, error) {
+	var body, _ = json.Marshal(params)
+	resp, err := client.PostWithContext(ctx, t.Endpoint(), string(body))
+
+	respData, err := SendResp(resp, err, t)
+	if err != nil {
+		return t, err
+	}
+	return &respData.Tax, err
+}
+
+// FindOne tries to find the tax with given id
+func (t *Tax) FindOne(id string, client *Client) (*Tax, error) {
+	return t.FindOneWithContext(context.Background(), id, client)
+}
+
+// FindOneWithContext tries to find the tax with given id using the given context
+func (t *Tax) FindOneWithContext(ctx context.Context, id string, client *Client) (*Tax, error) {
+	resp, err := client.GetWithContext(ctx, t.Endpoint()+"/"+id)
+	respData, err := SendResp(resp, err, t)
+	if err != nil {
+		return t, err
+	}
+	return &respData.Tax, err
+}
+
+// FindAll will return the list of taxes and tax groups present in zohobooks org
+func (t *Tax) FindAll(client *Client) ([]Tax, error) {
+	return t.FindAllWithContext(context.Background(), client)
+}
+
+// FindAllWithContext will return the list of taxes using the given context
+func (t *Tax) FindAllWithContext(ctx context.Context, client *Client) ([]Tax, error) {
+	var results []Tax
+	err := t.Iterator(client).ForEach(ctx, func(tax Tax) error {
+		results = append(results, tax)
+		return nil
+	})
+	return results, err
+}
+
+// Iterator returns an iterator over all the pages of taxes
+func (t *Tax) Iterator(client *Client) *Iterator[Tax] {
+	return NewIterator(func(ctx context.Context, page, perPage int) ([]Tax, *PageContext, error) {
+		resp, err := client.GetWithContext(ctx, withPage(t.Endpoint(), nil, page, perPage))
+		respData, err := SendResp(resp, err, t)
+		if err != nil {
+			return nil, nil, err
+		}
+		return respData.Taxes, &respData.PageContext, nil
+	})
+}
+
+// Update method will try to update a tax on zohobooks
+func (t *Tax) Update(id string, params *TaxParams, client *Client) (*Tax, error) {
+	return t.UpdateWithContext(context.Background(), id, params, client)
+}
+
+// UpdateWithContext will try to update a tax using the given context
+func (t *Tax) UpdateWithContext(ctx context.Context, id string, params *TaxParams, client *Client) (*Tax, error) {
+	var body, _ = json.Marshal(params)
+	resp, err := client.PutWithContext(ctx, t.Endpoint()+"/"+id, string(body))
+
+	respData, err := SendResp(resp, err, t)
+	if err != nil {
+		return t, err
+	}
+	return &respData.Tax, err
+}
+
+// Delete tries to delete the tax with given id
+func (t *Tax) Delete(id string, client *Client) error {
+	return t.DeleteWithContext(context.Background(), id, client)
+}
+
+// DeleteWithContext tries to delete the tax with given id using the given context
+func (t *Tax) DeleteWithContext(ctx context.Context, id string, client *Client) error {
+	resp, err := client.DeleteWithContext(ctx, t.Endpoint()+"/"+id)
+	_, err = SendResp(resp, err, t)
+	return err
+}
+
+// FindByName returns the tax whose name matches, ignoring case, so that its
+// ID can be used as the TaxID of a LineItem. ErrTaxNotFound is returned when
+// no tax has the name
+func (t *Tax) FindByName(name string, client *Client) (*Tax, error) {
+	return t.FindByNameWithContext(context.Background(), name, client)
+}
+
+// FindByNameWithContext returns the tax whose name matches using the given context
+func (t *Tax) FindByNameWithContext(ctx context.Context, name string, client *Client) (*Tax, error) {
+	taxes, err := t.FindAllWithContext(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	for i := range taxes {
+		if strings.EqualFold(taxes[i].Name, name) {
+			return &taxes[i], nil
+		}
+	}
+	return nil, ErrTaxNotFound
+}
+
+// FindByPercentage returns the taxes and tax groups with the given percentage,
+// several taxes can share a percentage (e.g. IGST18 and the GST18 group) so
+// the caller picks the one to use. ErrTaxNotFound is returned when none matches
+func (t *Tax) FindByPercentage(percentage float64, client *Client) ([]Tax, error) {
+	return t.FindByPercentageWithContext(context.Background(), percentage, client)
+}
+
+// FindByPercentageWithContext returns the taxes with the given percentage using the given context
+func (t *Tax) FindByPercentageWithContext(ctx context.Context, percentage float64, client *Client) ([]Tax, error) {
+	taxes, err := t.FindAllWithContext(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	var results []Tax
+	for _, tax := range taxes {
+		if tax.Percentage == percentage {
+			results = append(results, tax)
+		}
+	}
+	if len(results) == 0 {
+		return nil, ErrTaxNotFound
+	}
+	return results, nil
+}
+
+// TaxGroup struct represents a group of taxes applied together
+type TaxGroup struct {
+	ID         string  `json:"tax_group_id"`
+	Name       string  `json:"tax_group_name"`
+	Percentage float64 `json:"tax_group_percentage"`
+	Taxes      []Tax   `json:"taxes"`
+}
+
+// TaxGroupParams struct represents the information to create a tax group
+type TaxGroupParams struct {
+	Name   string   `json:"tax_group_name"`
+	TaxIDs []string `json:"-"`
+}
+
+// MarshalJSON encodes the tax ids as the comma separated list expected by zohobooks
+func (p *TaxGroupParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&struct {
+		Name  string `json:"tax_group_name"`
+		Taxes string `json:"taxes"`
+	}{p.Name, strings.Join(p.TaxIDs, ",")})
+}
+
+// New method will create a tax group object and return a pointer to it
+func (tg *TaxGroup) New() Resource {
+	var obj = &TaxGroup{}
+	return obj
+}
+
+// Endpoint method returns the endpoint of the resource
+func (tg *TaxGroup) Endpoint() string {
+	return "/settings/taxgroups"
+}
+
+// Create method will try to create a tax group on zohobooks
+func (tg *TaxGroup) Create(params *TaxGroupParams, client *Client) (*TaxGroup, error) {
+	return tg.CreateWithContext(context.Background(), params, client)
+}
+
+// CreateWithContext will try to create a tax group using the given context
+func (tg *TaxGroup) CreateWithContext(ctx context.Context, params *TaxGroupParams, client *Client) (*TaxGroup, error) {
+	var body, _ = json.Marshal(params)
+	resp, err := client.PostWithContext(ctx, tg.Endpoint(), string(body))
+
+	respData, err := SendResp(resp, err, tg)
+	if err != nil {
+		return tg, err
+	}
+	return &respData.TaxGroup, err
+}
+
+// FindOne tries to find the tax group with given id
+func (tg *TaxGroup) FindOne(id string, client *Client) (*TaxGroup, error) {
+	return tg.FindOneWithContext(context.Background(), id, client)
+}
+
+// FindOneWithContext tries to find the tax group with given id using the given context
+func (tg *TaxGroup) FindOneWithContext(ctx context.Context, id string, client *Client) (*TaxGroup, error) {
+	resp, err := client.GetWithContext(ctx, tg.Endpoint()+"/"+id)
+	respData, err := SendResp(resp, err, tg)
+	if err != nil {
+		return tg, err
+	}
+	return &respData.TaxGroup, err
+}
+
+// Update method will try to update a tax group on zohobooks
+func (tg *TaxGroup) Update(id string, params *TaxGroupParams, client *Client) (*TaxGroup, error) {
+	return tg.UpdateWithContext(context.Background(), id, params, client)
+}
+
+// UpdateWithContext will try to update a tax group using the given context
+func (tg *TaxGroup) UpdateWithContext(ctx context.Context, id string, params *TaxGroupParams, client *Client) (*TaxGroup, error) {
+	var body, _ = json.Marshal(params)
+	resp, err := client.PutWithContext(ctx, tg.Endpoint()+"/"+id, string(body))
+
+	respData, err := SendResp(resp, err, tg)
+	if err != nil {
+		return tg, err
+	}
+	return &respData.TaxGroup, err
+}
+
+// Delete tries to delete the tax group with given id
+func (tg *TaxGroup) Delete(id string, client *Client) error {
+	return tg.DeleteWithContext(context.Background(), id, client)
+}
+
+// DeleteWithContext tries to delete the tax group with given id using the given context
+func (tg *TaxGroup) DeleteWithContext(ctx context.Context, id string, client *Client) error {
+	resp, err := client.DeleteWithContext(ctx, tg.Endpoint()+"/"+id)
+	_, err = SendResp(resp, err, tg)
+	return err
+}
+
+// TaxAuthority struct represents the authority to which taxes are paid
+type TaxAuthority struct {
+	ID                      string `json:"tax_authority_id"`
+	Name                    string `json:"tax_authority_name"`
+	Description             string `json:"description"`
+	RegistrationNumberLabel string `json:"registration_number_label"`
+	RegistrationNumber      string `json:"registration_number"`
+}
+
+// TaxAuthorityParams struct represents the information to create a tax authority
+type TaxAuthorityParams struct {
+	Name                    string `json:"tax_authority_name"`
+	Description             string `json:"description,omitempty"`
+	RegistrationNumberLabel string `json:"registration_number_label,omitempty"`
+	RegistrationNumber      string `json:"registration_number,omitempty"`
+}
+
+// New method will create a tax authority object and return a pointer to it
+func (ta *TaxAuthority) New() Resource {
+	var obj = &TaxAuthority{}
+	return obj
+}
+
+// Endpoint method returns the endpoint of the resource
+func (ta *TaxAuthority) Endpoint() string {
+	return "/settings/taxauthorities"
+}
+
+// Create method will try to create a tax authority on zohobooks
+func (ta *TaxAuthority) Create(params *TaxAuthorityParams, client *Client) (*TaxAuthority, error) {
+	return ta.CreateWithContext(context.Background(), params, client)
+}
+
+// CreateWithContext will try to create a tax authority using the given context
+func (ta *TaxAuthority) CreateWithContext(ctx context.Context, params *TaxAuthorityParams, client *Client) (*TaxAuthority, error) {
+	var body, _ = json.Marshal(params)
+	resp, err := client.PostWithContext(ctx, ta.Endpoint(), string(body))
+
+	respData, err := SendResp(resp, err, ta)
+	if err != nil {
+		return ta, err
+	}
+	return &respData.TaxAuthority, err
+}
+
+// FindOne tries to find the tax authority with given id
+func (ta *TaxAuthority) FindOne(id string, client *Client) (*TaxAuthority, error) {
+	return ta.FindOneWithContext(context.Background(), id, client)
+}
+
+// FindOneWithContext tries to find the tax authority with given id using the given context
+func (ta *TaxAuthority) FindOneWithContext(ctx context.Context, id string, client *Client) (*TaxAuthority, error) {
+	resp, err := client.GetWithContext(ctx, ta.Endpoint()+"/"+id)
+	respData, err := SendResp(resp, err, ta)
+	if err != nil {
+		return ta, err
+	}
+	return &respData.TaxAuthority, err
+}
+
+// FindAll will return the list of tax authorities present in zohobooks org
+func (ta *TaxAuthority) FindAll(client *Client) ([]TaxAuthority, error) {
+	return ta.FindAllWithContext(context.Background(), client)
+}
+
+// FindAllWithContext will return the list of tax authorities using the given context
+func (ta *TaxAuthority) FindAllWithContext(ctx context.Context, client *Client) ([]TaxAuthority, error) {
+	var results []TaxAuthority
+	resp, err := client.GetWithContext(ctx, ta.Endpoint())
+	respData, err := SendResp(resp, err, ta)
+	if err != nil {
+		return results, err
+	}
+	for _, auth := range respData.TaxAuthorities {
+		results = append(results, auth)
+	}
+	return results, nil
+}
+
+// Update method will try to update a tax authority on zohobooks
+func (ta *TaxAuthority) Update(id string, params *TaxAuthorityParams, client *Client) (*TaxAuthority, error) {
+	return ta.UpdateWithContext(context.Background(), id, params, client)
+}
+
+// UpdateWithContext will try to update a tax authority using the given context
+func (ta *TaxAuthority) UpdateWithContext(ctx context.Context, id string, params *TaxAuthorityParams, client *Client) (*TaxAuthority, error) {
+	var body, _ = json.Marshal(params)
+	resp, err := client.PutWithContext(ctx, ta.Endpoint()+"/"+id, string(body))
+
+	respData, err := SendResp(resp, err, ta)
+	if err != nil {
+		return ta, err
+	}
+	return &respData.TaxAuthority, err
+}
+
+// Delete tries to delete the tax authority with given id
+func (ta *TaxAuthority) Delete(id string, client *Client) error {
+	return ta.DeleteWithContext(context.Background(), id, client)
+}
+
+// DeleteWithContext tries to delete the tax authority with given id using the given context
+func (ta *TaxAuthority) DeleteWithContext(ctx context.Context, id string, client *Client) error {
+	resp, err := client.DeleteWithContext(ctx, ta.Endpoint()+"/"+id)
+	_, err = SendResp(resp, err, ta)
+	return err
+}
+
+// TaxExemption struct represents a reason for which a customer or item is exempt from tax
+type TaxExemption struct {
+	ID          string `json:"tax_exemption_id"`
+	Code        string `json:"tax_exemption_code"`
+	Description string `json:"description"`
+	Type        string `json:"type"` // customer or item
+}
+
+// TaxExemptionParams struct represents the information to create a tax exemption
+type TaxExemptionParams struct {
+	Code        string `json:"tax_exemption_code"`
+	Description string `json:"description,omitempty"`
+	Type        string `json:"type"`
+}
+
+// New method will create a tax exemption object and return a pointer to it
+func (te *TaxExemption) New() Resource {
+	var obj = &TaxExemption{}
+	return obj
+}
+
+// Endpoint method returns the endpoint of the resource
+func (te *TaxExemption) Endpoint() string {
+	return "/settings/taxexemptions"
+}
+
+// Create method will try to create a tax exemption on zohobooks
+func (te *TaxExemption) Create(params *TaxExemptionParams, client *Client) (*TaxExemption, error) {
+	return te.CreateWithContext(context.Background(), params, client)
+}
+
+// CreateWithContext will try to create a tax exemption using the given context
+func (te *TaxExemption) CreateWithContext(ctx context.Context, params *TaxExemptionParams, client *Client) (*TaxExemption, error) {
+	var body, _ = json.Marshal(params)
+	resp, err := client.PostWithContext(ctx, te.Endpoint(), string(body))
+
+	respData, err := SendResp(resp, err, te)
+	if err != nil {
+		return te, err
+	}
+	return &respData.TaxExemption, err
+}
+
+// FindOne tries to find the tax exemption with given id
+func (te *TaxExemption) FindOne(id string, client *Client) (*TaxExemption, error) {
+	return te.FindOneWithContext(context.Background(), id, client)
+}
+
+// FindOneWithContext tries to find the tax exemption with given id using the given context
+func (te *TaxExemption) FindOneWithContext(ctx context.Context, id string, client *Client) (*TaxExemption, error) {
+	resp, err := client.GetWithContext(ctx, te.Endpoint()+"/"+id)
+	respData, err := SendResp(resp, err, te)
+	if err != nil {
+		return te, err
+	}
+	return &respData.TaxExemption, err
+}
+
+// FindAll will return the list of tax exemptions present in zohobooks org
+func (te *TaxExemption) FindAll(client *Client) ([]TaxExemption, error) {
+	return te.FindAllWithContext(context.Background(), client)
+}
+
+// FindAllWithContext will return the list of tax exemptions using the given context
+func (te *TaxExemption) FindAllWithContext(ctx context.Context, client *Client) ([]TaxExemption, error) {
+	var results []TaxExemption
+	resp, err := client.GetWithContext(ctx, te.Endpoint())
+	respData, err := SendResp(resp, err, te)
+	if err != nil {
+		return results, err
+	}
+	for _, ex := range respData.TaxExemptions {
+		results = append(results, ex)
+	}
+	return results, nil
+}
+
+// Update method will try to update a tax exemption on zohobooks
+func (te *TaxExemption) Update(id string, params *TaxExemptionParams, client *Client) (*TaxExemption, error) {
+	return te.UpdateWithContext(context.Background(), id, params, client)
+}
+
+// UpdateWithContext will try to update a tax exemption using the given context
+func (te *TaxExemption) UpdateWithContext(ctx context.Context, id string, params *TaxExemptionParams, client *Client) (*TaxExemption, error) {
+	var body, _ = json.Marshal(params)
+	resp, err := client.PutWithContext(ctx, te.Endpoint()+"/"+id, string(body))
+
+	respData, err := SendResp(resp, err, te)
+	if err != nil {
+		return te, err
+	}
+	return &respData.TaxExemption, err
+}
+
+// Delete tries to delete the tax exemption with given id
+func (te *TaxExemption) Delete(id string, client *Client) error {
+	return te.DeleteWithContext(context.Background(), id, client)
+}
+
+// DeleteWithContext tries to delete the tax exemption with given id using the given context
+func (te *TaxExemption) DeleteWithContext(ctx context.Context, id string, client *Client) error {
+	resp, err := client.DeleteWithContext(ctx, te.Endpoint()+"/"+id)
+	_, err = SendResp(resp, err, te)
+	return err
+}
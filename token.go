@@ -0,0 +1,160 @@
+package zohobooks
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// tokenRefreshSkew is how long before its expiry an access token is refreshed
+const tokenRefreshSkew = time.Minute
+
+// defaultRefreshTimeout bounds a token refresh when the http client of the
+// Client has no timeout
+const defaultRefreshTimeout = 30 * time.Second
+
+// refreshCall tracks an access token refresh in flight so that concurrent
+// requests wait for it instead of hitting the accounts endpoint themselves
+type refreshCall struct {
+	done  chan struct{}
+	token string
+	err   error
+}
+
+// tokenState guards the token fields of a client and tracks its refresh in
+// flight. The clients returned by ForOrg share the state of their parent so
+// that they make a single refresh between them
+type tokenState struct {
+	mu         sync.Mutex
+	refreshing *refreshCall
+}
+
+// state returns the token state of the client, creating it on first use so
+// that clients built without a constructor work too
+func (c *Client) state() *tokenState {
+	c.tokenOnce.Do(func() {
+		if c.tokens == nil {
+			c.tokens = &tokenState{}
+		}
+	})
+	return c.tokens
+}
+
+// canRefresh reports whether the client holds the credentials needed to
+// generate a new access token
+func (c *Client) canRefresh() bool {
+	c.state().mu.Lock()
+	defer c.state().mu.Unlock()
+	return len(c.refreshToken) > 0 && len(c.clientID) > 0 && len(c.clientSecret) > 0
+}
+
+// loadToken returns the current token from the token store, falling back to
+// the OAuthToken field when the store is empty or not configured. The refresh
+// token of the stored token is used when the client has none
+func (c *Client) loadToken(ctx context.Context) (*Token, error) {
+	if c.tokenStore != nil {
+		token, err := c.tokenStore.Load(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if token != nil && len(token.AccessToken) > 0 {
+			c.state().mu.Lock()
+			c.OAuthToken = token.AccessToken
+			// clients sharing the store may be built with only the client
+			// credentials, they take the refresh token from the store
+			if len(c.refreshToken) == 0 {
+				c.refreshToken = token.RefreshToken
+			}
+			c.state().mu.Unlock()
+			return token, nil
+		}
+	}
+	c.state().mu.Lock()
+	defer c.state().mu.Unlock()
+	return &Token{AccessToken: c.OAuthToken}, nil
+}
+
+// accessToken returns the access token to be used for a request, refreshing
+// it first when it has expired or is about to expire
+func (c *Client) accessToken(ctx context.Context) (string, error) {
+	token, err := c.loadToken(ctx)
+	if err != nil {
+		return "", err
+	}
+	if token.Valid() || !c.canRefresh() {
+		return token.AccessToken, nil
+	}
+	return c.refreshAccessToken(ctx, token.AccessToken)
+}
+
+// refreshAccessToken replaces the stale access token with a new one. Only one
+// refresh is made at a time, other callers wait for its result
+func (c *Client) refreshAccessToken(ctx context.Context, stale string) (string, error) {
+	c.state().mu.Lock()
+	call := c.state().refreshing
+	if call == nil {
+		call = &refreshCall{done: make(chan struct{})}
+		c.state().refreshing = call
+		// the refresh is shared by every waiting caller so it must not end
+		// with the context of the caller which happened to start it
+		go c.doRefresh(context.WithoutCancel(ctx), call, stale)
+	}
+	c.state().mu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.token, call.err
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+func (c *Client) doRefresh(ctx context.Context, call *refreshCall, stale string) {
+	var timeout = defaultRefreshTimeout
+	if c.httpClient != nil && c.httpClient.Timeout > 0 {
+		timeout = c.httpClient.Timeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	defer func() {
+		c.state().mu.Lock()
+		c.state().refreshing = nil
+		c.state().mu.Unlock()
+		close(call.done)
+	}()
+
+	// another client sharing the store may have refreshed the token already
+	token, err := c.loadToken(ctx)
+	if err != nil {
+		call.err = err
+		return
+	}
+	if token.AccessToken != stale && token.Valid() {
+		call.token = token.AccessToken
+		return
+	}
+
+	oauthResp, err := c.requestAccessToken(ctx)
+	if err != nil {
+		call.err = err
+		return
+	}
+	token = oauthResp.token()
+	if len(token.RefreshToken) == 0 {
+		// the refresh token grant does not return the refresh token, keep the
+		// current one so that saving the token does not drop it from the store
+		c.state().mu.Lock()
+		token.RefreshToken = c.refreshToken
+		c.state().mu.Unlock()
+	}
+	if c.tokenStore != nil {
+		if err := c.tokenStore.Save(ctx, token); err != nil {
+			call.err = err
+			return
+		}
+	}
+	c.state().mu.Lock()
+	c.OAuthToken = token.AccessToken
+	c.state().mu.Unlock()
+	call.token = token.AccessToken
+}
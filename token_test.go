@@ -0,0 +1,271 @@
+package zohobooks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// rewriteTransport sends every request to the test server whatever its host,
+// so that the zoho accounts and api urls of the client can be served locally
+type rewriteTransport struct {
+	target *url.URL
+}
+
+func (t rewriteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.URL.Scheme = t.target.Scheme
+	req.URL.Host = t.target.Host
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func newTestClient(t *testing.T, handler http.Handler, conf *ClientConfig) *Client {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	target, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewClientWithConfig(conf)
+	c.httpClient = &http.Client{Transport: rewriteTransport{target}}
+	return c
+}
+
+func testConfig(store TokenStore) *ClientConfig {
+	return &ClientConfig{
+		ClientID:     "id",
+		ClientSecret: "secret",
+		RefreshToken: "r1",
+		OrgID:        "1",
+		TokenStore:   store,
+	}
+}
+
+func expiredToken() *Token {
+	return &Token{AccessToken: "old", RefreshToken: "r1", Expiry: time.Now().Add(-time.Hour)}
+}
+
+// tokenHandler answers the refresh token grant with the given access token
+func tokenHandler(refreshes *int32, accessToken string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(refreshes, 1)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":%q,"token_type":"Bearer","expires_in":3600}`, accessToken)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, body string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	fmt.Fprint(w, body)
+}
+
+func TestRefreshKeepsRefreshToken(t *testing.T) {
+	var refreshes int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/oauth/v2/token", tokenHandler(&refreshes, "new"))
+	mux.HandleFunc("/books/v3/contacts/1", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Zoho-oauthtoken new" {
+			t.Errorf("Authorization = %q, want the refreshed token", got)
+		}
+		writeJSON(w, http.StatusOK, `{"code":0,"contact":{"contact_id":"1"}}`)
+	})
+	store := NewMemoryTokenStore(expiredToken())
+	c := newTestClient(t, mux, testConfig(store))
+
+	contact, err := (&Contact{}).FindOne("1", c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if contact.ID != "1" {
+		t.Errorf("contact id = %q, want 1", contact.ID)
+	}
+	token, _ := store.Load(context.Background())
+	if token.AccessToken != "new" || token.RefreshToken != "r1" {
+		t.Errorf("stored token = %+v, want access token new and refresh token r1", token)
+	}
+	if n := atomic.LoadInt32(&refreshes); n != 1 {
+		t.Errorf("refreshes = %d, want 1", n)
+	}
+}
+
+func TestRefreshOnlyOnInvalidToken(t *testing.T) {
+	var refreshes, calls int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/oauth/v2/token", tokenHandler(&refreshes, "new"))
+	mux.HandleFunc("/books/v3/contacts/denied", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusUnauthorized, `{"code":57,"message":"You are not authorized to perform this operation"}`)
+	})
+	mux.HandleFunc("/books/v3/contacts/1", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			writeJSON(w, http.StatusUnauthorized, `{"code":14,"message":"Invalid OAuth Token"}`)
+			return
+		}
+		writeJSON(w, http.StatusOK, `{"code":0,"contact":{"contact_id":"1"}}`)
+	})
+	c := newTestClient(t, mux, testConfig(NewMemoryTokenStore(&Token{AccessToken: "old"})))
+
+	for i := 0; i < 3; i++ {
+		_, err := (&Contact{}).FindOne("denied", c)
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) || apiErr.Code != ErrCodeNotAuthorized {
+			t.Fatalf("err = %v, want code %d", err, ErrCodeNotAuthorized)
+		}
+	}
+	if n := atomic.LoadInt32(&refreshes); n != 0 {
+		t.Fatalf("refreshes = %d after permission errors, want 0", n)
+	}
+
+	if _, err := (&Contact{}).FindOne("1", c); err != nil {
+		t.Fatal(err)
+	}
+	if n := atomic.LoadInt32(&refreshes); n != 1 || atomic.LoadInt32(&calls) != 2 {
+		t.Errorf("refreshes = %d, calls = %d, want 1 refresh and the request retried once", n, atomic.LoadInt32(&calls))
+	}
+}
+
+func TestRefreshIsSharedAndOutlivesCaller(t *testing.T) {
+	var refreshes int32
+	var started = make(chan struct{})
+	var release = make(chan struct{})
+	mux := http.NewServeMux()
+	mux.HandleFunc("/oauth/v2/token", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&refreshes, 1) == 1 {
+			close(started)
+		}
+		<-release
+		tokenHandler(new(int32), "new")(w, r)
+	})
+	c := newTestClient(t, mux, testConfig(NewMemoryTokenStore(expiredToken())))
+
+	var first = make(chan error, 1)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		_, err := c.accessToken(ctx)
+		first <- err
+	}()
+	<-started
+
+	var wg sync.WaitGroup
+	var results = make([]string, 5)
+	var errs = make([]error, 5)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], errs[i] = c.accessToken(context.Background())
+		}(i)
+	}
+
+	if err := <-first; !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("first caller err = %v, want its own deadline", err)
+	}
+	close(release)
+	wg.Wait()
+	for i := range results {
+		if errs[i] != nil || results[i] != "new" {
+			t.Errorf("waiter %d got %q, %v, want the refreshed token", i, results[i], errs[i])
+		}
+	}
+	if n := atomic.LoadInt32(&refreshes); n != 1 {
+		t.Errorf("refreshes = %d, want 1", n)
+	}
+}
+
+func TestRefreshTokenLoadedFromStore(t *testing.T) {
+	var refreshes int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/oauth/v2/token", func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil || r.Form.Get("refresh_token") != "r1" {
+			t.Errorf("refresh_token = %q, want the stored one", r.Form.Get("refresh_token"))
+		}
+		tokenHandler(&refreshes, "new")(w, r)
+	})
+	var path = filepath.Join(t.TempDir(), "token.json")
+	if err := NewFileTokenStore(path).Save(context.Background(), expiredToken()); err != nil {
+		t.Fatal(err)
+	}
+
+	// a worker sharing the store only knows the client credentials
+	var conf = testConfig(NewFileTokenStore(path))
+	conf.RefreshToken = ""
+	c := newTestClient(t, mux, conf)
+	token, err := c.accessToken(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "new" {
+		t.Errorf("access token = %q, want new", token)
+	}
+
+	stored, err := NewFileTokenStore(path).Load(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.AccessToken != "new" || stored.RefreshToken != "r1" || !stored.Valid() {
+		t.Errorf("stored token = %+v, want a valid token new with refresh token r1", stored)
+	}
+	if n := atomic.LoadInt32(&refreshes); n != 1 {
+		t.Errorf("refreshes = %d, want 1", n)
+	}
+}
+
+func TestOAuthParamsSentInBody(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/oauth/v2/token", func(w http.ResponseWriter, r *http.Request) {
+		if len(r.URL.RawQuery) > 0 {
+			t.Errorf("query = %q, want the parameters in the body", r.URL.RawQuery)
+		}
+		if err := r.ParseForm(); err != nil {
+			t.Error(err)
+		}
+		if r.PostForm.Get("client_secret") != "secret" || r.PostForm.Get("code") != "c1" {
+			t.Errorf("form = %v, want the client secret and code", r.PostForm)
+		}
+		writeJSON(w, http.StatusOK, `{"access_token":"a1","refresh_token":"r2","expires_in":3600}`)
+	})
+	c := newTestClient(t, mux, testConfig(nil))
+
+	token, err := c.ExchangeCode("c1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token.AccessToken != "a1" || token.RefreshToken != "r2" {
+		t.Errorf("token = %+v, want a1 and r2", token)
+	}
+}
+
+func TestForOrgSharesRefresh(t *testing.T) {
+	var refreshes int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/oauth/v2/token", func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(50 * time.Millisecond)
+		tokenHandler(&refreshes, "new")(w, r)
+	})
+	c := newTestClient(t, mux, testConfig(NewMemoryTokenStore(expiredToken())))
+
+	var wg sync.WaitGroup
+	for _, orgID := range []string{"1", "2", "3", "4"} {
+		wg.Add(1)
+		go func(org *Client) {
+			defer wg.Done()
+			if token, err := org.accessToken(context.Background()); err != nil || token != "new" {
+				t.Errorf("org %s got %q, %v, want the refreshed token", org.OrgID, token, err)
+			}
+		}(c.ForOrg(orgID))
+	}
+	wg.Wait()
+	if n := atomic.LoadInt32(&refreshes); n != 1 {
+		t.Errorf("refreshes = %d, want 1 for all the org clients", n)
+	}
+}
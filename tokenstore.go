@@ -0,0 +1,141 @@
+package zohobooks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Token struct holds an oauth access token along with its expiry
+type Token struct {
+	AccessToken  string    `json:"access_token"`
+	RefreshToken string    `json:"refresh_token,omitempty"`
+	TokenType    string    `json:"token_type,omitempty"`
+	APIDomain    string    `json:"api_domain,omitempty"`
+	Expiry       time.Time `json:"expiry,omitempty"`
+}
+
+// Valid reports whether the token is present and not about to expire. A token
+// without an expiry is considered valid until zoho rejects it
+func (t *Token) Valid() bool {
+	if t == nil || len(t.AccessToken) == 0 {
+		return false
+	}
+	return t.Expiry.IsZero() || time.Until(t.Expiry) > tokenRefreshSkew
+}
+
+// TokenStore interface is used by the client to load and persist access
+// tokens, so that a refreshed token can be shared by multiple clients
+type TokenStore interface {
+	// Load returns the stored token, or nil when no token has been saved yet
+	Load(ctx context.Context) (*Token, error)
+	// Save persists the given token replacing the previous one
+	Save(ctx context.Context, token *Token) error
+}
+
+// MemoryTokenStore keeps the token in memory, it can be shared by clients
+// running in the same process
+type MemoryTokenStore struct {
+	mu    sync.RWMutex
+	token *Token
+}
+
+// NewMemoryTokenStore returns a memory store holding the given token
+func NewMemoryTokenStore(token *Token) *MemoryTokenStore {
+	var s = &MemoryTokenStore{}
+	if token != nil {
+		var t = *token
+		s.token = &t
+	}
+	return s
+}
+
+// Load returns a copy of the stored token
+func (s *MemoryTokenStore) Load(ctx context.Context) (*Token, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.token == nil {
+		return nil, nil
+	}
+	var t = *s.token
+	return &t, nil
+}
+
+// Save stores a copy of the given token
+func (s *MemoryTokenStore) Save(ctx context.Context, token *Token) error {
+	if token == nil {
+		return errors.New("token is nil")
+	}
+	var t = *token
+	s.mu.Lock()
+	s.token = &t
+	s.mu.Unlock()
+	return nil
+}
+
+// FileTokenStore keeps the token as JSON in a file, it can be shared by
+// processes running on the same host or a shared volume
+type FileTokenStore struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewFileTokenStore returns a store which persists the token to the given path
+func NewFileTokenStore(path string) *FileTokenStore {
+	return &FileTokenStore{path: path}
+}
+
+// Load reads the token from the file, a missing file returns a nil token
+func (s *FileTokenStore) Load(ctx context.Context) (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data, err := ioutil.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var token = &Token{}
+	if err := json.Unmarshal(data, token); err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+// Save writes the token to a temporary file and renames it over the previous
+// one, so that readers never see a partially written token
+func (s *FileTokenStore) Save(ctx context.Context, token *Token) error {
+	if token == nil {
+		return errors.New("token is nil")
+	}
+	data, err := json.Marshal(token)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f, err := ioutil.TempFile(filepath.Dir(s.path), filepath.Base(s.path)+".tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	if err := os.Chmod(f.Name(), 0600); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	return os.Rename(f.Name(), s.path)
+}
@@ -0,0 +1,231 @@
+package zohobooks
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+)
+
+// UserEmail struct represents an email address of the user
+type UserEmail struct {
+	Email      string `json:"email"`
+	IsSelected bool   `json:"is_selected"`
+}
+
+// User struct represents the information of a user of the organization
+type User struct {
+	ID            string      `json:"user_id"`
+	RoleID        string      `json:"role_id"`
+	Name          string      `json:"name"`
+	Email         string      `json:"email"`
+	EmailIDs      []UserEmail `json:"email_ids"`
+	Status        string      `json:"status"`    // active, inactive, invited or deleted
+	UserRole      string      `json:"user_role"` // e.g. admin, staff, accountant
+	UserType      string      `json:"user_type"`
+	IsCurrentUser bool        `json:"is_current_user"`
+	PhotoURL      string      `json:"photo_url"`
+	CostRate      float64     `json:"cost_rate"`
+	CreatedTime   string      `json:"created_time"`
+}
+
+// UserFindOptions struct contains the filters for listing users, FilterBy
+// takes Status.All, Status.Active, Status.Inactive, Status.Invited or Status.Deleted
+type UserFindOptions struct {
+	ListOptions
+}
+
+// Values encodes the options as query parameters
+func (o *UserFindOptions) Values() url.Values {
+	if o == nil {
+		return url.Values{}
+	}
+	return o.ListOptions.Values()
+}
+
+// Role struct represents a role which can be assigned to a user
+type Role struct {
+	ID   string
+	Name string
+}
+
+// UserParams struct represents the information to invite or update a user.
+// The RoleID is found with User.Roles, or in the web app under Settings >
+// Users & Roles > Roles for a role which no user has yet
+type UserParams struct {
+	Name     string  `json:"name"`
+	Email    string  `json:"email"`
+	RoleID   string  `json:"role_id,omitempty"`
+	CostRate float64 `json:"cost_rate,omitempty"`
+}
+
+// New method will create a user object and return a pointer to it
+func (u *User) New() Resource {
+	var obj = &User{}
+	return obj
+}
+
+// Endpoint method returns the endpoint of the resource
+func (u *User) Endpoint() string {
+	return "/users"
+}
+
+// Create method will invite a user to the organization, the user stays in
+// the invited status until the invitation is accepted
+func (u *User) Create(params *UserParams, client *Client) (*User, error) {
+	return u.CreateWithContext(context.Background(), params, client)
+}
+
+// CreateWithContext will invite a user using the given context
+func (u *User) CreateWithContext(ctx context.Context, params *UserParams, client *Client) (*User, error) {
+	var body, _ = json.Marshal(params)
+	resp, err := client.PostWithContext(ctx, u.Endpoint(), string(body))
+
+	respData, err := SendResp(resp, err, u)
+	if err != nil {
+		return u, err
+	}
+	return &respData.User, err
+}
+
+// FindOne tries to find the user with given id
+func (u *User) FindOne(id string, client *Client) (*User, error) {
+	return u.FindOneWithContext(context.Background(), id, client)
+}
+
+// FindOneWithContext tries to find the user with given id using the given context
+func (u *User) FindOneWithContext(ctx context.Context, id string, client *Client) (*User, error) {
+	resp, err := client.GetWithContext(ctx, u.Endpoint()+"/"+id)
+	respData, err := SendResp(resp, err, u)
+	if err != nil {
+		return u, err
+	}
+	return &respData.User, err
+}
+
+// Me returns the user the access token belongs to
+func (u *User) Me(client *Client) (*User, error) {
+	return u.MeWithContext(context.Background(), client)
+}
+
+// MeWithContext returns the user the access token belongs to using the given context
+func (u *User) MeWithContext(ctx context.Context, client *Client) (*User, error) {
+	return u.FindOneWithContext(ctx, "me", client)
+}
+
+// FindAll tries to find the users with given options
+func (u *User) FindAll(opts *UserFindOptions, client *Client) ([]User, error) {
+	return u.FindAllWithContext(context.Background(), opts, client)
+}
+
+// FindAllWithContext tries to find the users with given options using the given context
+func (u *User) FindAllWithContext(ctx context.Context, opts *UserFindOptions, client *Client) ([]User, error) {
+	resp, err := client.GetWithContext(ctx, withQuery(u.Endpoint(), opts))
+	respData, err := SendResp(resp, err, u)
+
+	var results []User
+	if err != nil {
+		return results, err
+	}
+	for _, usr := range respData.Users {
+		results = append(results, usr)
+	}
+	return results, err
+}
+
+// Iterator returns an iterator over all the pages of users with given options
+func (u *User) Iterator(opts *UserFindOptions, client *Client) *Iterator[User] {
+	return NewIterator(func(ctx context.Context, page, perPage int) ([]User, *PageContext, error) {
+		resp, err := client.GetWithContext(ctx, withPage(u.Endpoint(), opts, page, perPage))
+		respData, err := SendResp(resp, err, u)
+		if err != nil {
+			return nil, nil, err
+		}
+		return respData.Users, &respData.PageContext, nil
+	})
+}
+
+// Update method will try to update a user, the role is changed through RoleID
+func (u *User) Update(id string, params *UserParams, client *Client) (*User, error) {
+	return u.UpdateWithContext(context.Background(), id, params, client)
+}
+
+// UpdateWithContext will try to update a user using the given context
+func (u *User) UpdateWithContext(ctx context.Context, id string, params *UserParams, client *Client) (*User, error) {
+	var body, _ = json.Marshal(params)
+	resp, err := client.PutWithContext(ctx, u.Endpoint()+"/"+id, string(body))
+
+	respData, err := SendResp(resp, err, u)
+	if err != nil {
+		return u, err
+	}
+	return &respData.User, err
+}
+
+// Delete tries to delete the user with given id
+func (u *User) Delete(id string, client *Client) error {
+	return u.DeleteWithContext(context.Background(), id, client)
+}
+
+// DeleteWithContext tries to delete the user with given id using the given context
+func (u *User) DeleteWithContext(ctx context.Context, id string, client *Client) error {
+	resp, err := client.DeleteWithContext(ctx, u.Endpoint()+"/"+id)
+	_, err = SendResp(resp, err, u)
+	return err
+}
+
+// ResendInvite sends the invitation again to a user in the invited status
+func (u *User) ResendInvite(id string, client *Client) error {
+	return u.ResendInviteWithContext(context.Background(), id, client)
+}
+
+// ResendInviteWithContext sends the invitation again using the given context
+func (u *User) ResendInviteWithContext(ctx context.Context, id string, client *Client) error {
+	resp, err := client.postAction(ctx, u.Endpoint()+"/"+id+"/invite")
+	_, err = SendResp(resp, err, u)
+	return err
+}
+
+// MarkActive marks the user with given id as active
+func (u *User) MarkActive(id string, client *Client) error {
+	return u.MarkActiveWithContext(context.Background(), id, client)
+}
+
+// MarkActiveWithContext marks the user with given id as active using the given context
+func (u *User) MarkActiveWithContext(ctx context.Context, id string, client *Client) error {
+	resp, err := client.postAction(ctx, u.Endpoint()+"/"+id+"/active")
+	_, err = SendResp(resp, err, u)
+	return err
+}
+
+// MarkInactive marks the user with given id as inactive
+func (u *User) MarkInactive(id string, client *Client) error {
+	return u.MarkInactiveWithContext(context.Background(), id, client)
+}
+
+// MarkInactiveWithContext marks the user with given id as inactive using the given context
+func (u *User) MarkInactiveWithContext(ctx context.Context, id string, client *Client) error {
+	resp, err := client.postAction(ctx, u.Endpoint()+"/"+id+"/inactive")
+	_, err = SendResp(resp, err, u)
+	return err
+}
+
+// Roles returns the roles assigned to the users of the organization. The API
+// has no endpoint listing the roles so they are collected from the users, a
+// role which no user has is not returned
+func (u *User) Roles(client *Client) ([]Role, error) {
+	return u.RolesWithContext(context.Background(), client)
+}
+
+// RolesWithContext returns the roles assigned to the users using the given context
+func (u *User) RolesWithContext(ctx context.Context, client *Client) ([]Role, error) {
+	var roles []Role
+	var seen = map[string]bool{}
+	err := u.Iterator(nil, client).ForEach(ctx, func(usr User) error {
+		if len(usr.RoleID) > 0 && !seen[usr.RoleID] {
+			seen[usr.RoleID] = true
+			roles = append(roles, Role{ID: usr.RoleID, Name: usr.UserRole})
+		}
+		return nil
+	})
+	return roles, err
+}
@@ -0,0 +1,178 @@
+package zohobooks
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+)
+
+// BillInfo struct contains the amount of a vendor payment applied to a bill
+type BillInfo struct {
+	BillID            string  `json:"bill_id"`
+	Number            string  `json:"bill_number,omitempty"`
+	Date              string  `json:"date,omitempty"`
+	Amount            float64 `json:"total,omitempty"`
+	AmountApplied     float64 `json:"amount_applied"`
+	BalanceAmount     float64 `json:"balance,omitempty"`
+	TaxAmountWithheld float64 `json:"tax_amount_withheld,omitempty"`
+}
+
+// VendorPayment struct represents the information of a payment made to a vendor
+type VendorPayment struct {
+	ID             string     `json:"payment_id"`
+	PaymentNumber  string     `json:"payment_number"`
+	Mode           string     `json:"payment_mode"`
+	Amount         float64    `json:"amount"`
+	Balance        float64    `json:"balance"`
+	BankCharges    float64    `json:"bank_charges"`
+	Date           string     `json:"date"`
+	Status         string     `json:"status"`
+	RefNo          string     `json:"reference_number"`
+	Description    string     `json:"description"`
+	VendorID       string     `json:"vendor_id"`
+	VendorName     string     `json:"vendor_name"`
+	PaidThroughID  string     `json:"paid_through_account_id"`
+	PaidThrough    string     `json:"paid_through_account_name"`
+	Bills          []BillInfo `json:"bills"`
+	CurrencyCode   string     `json:"currency_code"`
+	CurrencySymbol string     `json:"currency_symbol"`
+	ExchangeRate   float64    `json:"exchange_rate"`
+}
+
+// VendorPaymentParams struct represents the information to create a vendor
+// payment, Bills contains the amount applied to each bill
+type VendorPaymentParams struct {
+	VendorID    string  `json:"vendor_id"`
+	Mode        string  `json:"payment_mode,omitempty"`
+	Amount      float64 `json:"amount"`
+	Date        string  `json:"date"`
+	RefNo       string  `json:"reference_number,omitempty"`
+	Description string  `json:"description,omitempty"`
+
+	Bills         []BillInfo `json:"bills"`
+	BankCharges   float64    `json:"bank_charges,omitempty"`
+	PaidThroughID string     `json:"paid_through_account_id,omitempty"`
+	ExchangeRate  float64    `json:"exchange_rate,omitempty"`
+}
+
+// VendorPaymentFindOptions struct contains the filters used to list vendor payments
+type VendorPaymentFindOptions struct {
+	VendorID string
+	BillID   string
+	ListOptions
+}
+
+// Values encodes the options as query parameters
+func (o *VendorPaymentFindOptions) Values() url.Values {
+	if o == nil {
+		return url.Values{}
+	}
+	var v = o.ListOptions.Values()
+	setString(v, "vendor_id", o.VendorID)
+	setString(v, "bill_id", o.BillID)
+	return v
+}
+
+// New method will create a vendor payment object and return a pointer to it
+func (vp *VendorPayment) New() Resource {
+	var obj = &VendorPayment{}
+	return obj
+}
+
+// Endpoint method returns the endpoint of the resource
+func (vp *VendorPayment) Endpoint() string {
+	return "/vendorpayments"
+}
+
+// Create method will try to create a vendor payment applied to the bills in params
+func (vp *VendorPayment) Create(params *VendorPaymentParams, client *Client) (*VendorPayment, error) {
+	return vp.CreateWithContext(context.Background(), params, client)
+}
+
+// CreateWithContext will try to create a vendor payment using the given context
+func (vp *VendorPayment) CreateWithContext(ctx context.Context, params *VendorPaymentParams, client *Client) (*VendorPayment, error) {
+	var body, _ = json.Marshal(params)
+	resp, err := client.PostWithContext(ctx, vp.Endpoint(), string(body))
+
+	respData, err := SendResp(resp, err, vp)
+	if err != nil {
+		return vp, err
+	}
+	return &respData.VendorPayment, err
+}
+
+// FindOne tries to find the vendor payment with given id
+func (vp *VendorPayment) FindOne(id string, client *Client) (*VendorPayment, error) {
+	return vp.FindOneWithContext(context.Background(), id, client)
+}
+
+// FindOneWithContext tries to find the vendor payment with given id using the given context
+func (vp *VendorPayment) FindOneWithContext(ctx context.Context, id string, client *Client) (*VendorPayment, error) {
+	resp, err := client.GetWithContext(ctx, vp.Endpoint()+"/"+id)
+	respData, err := SendResp(resp, err, vp)
+	if err != nil {
+		return vp, err
+	}
+	return &respData.VendorPayment, err
+}
+
+// FindAll tries to find the vendor payments with given options
+func (vp *VendorPayment) FindAll(opts *VendorPaymentFindOptions, client *Client) ([]VendorPayment, error) {
+	return vp.FindAllWithContext(context.Background(), opts, client)
+}
+
+// FindAllWithContext tries to find the vendor payments with given options using the given context
+func (vp *VendorPayment) FindAllWithContext(ctx context.Context, opts *VendorPaymentFindOptions, client *Client) ([]VendorPayment, error) {
+	resp, err := client.GetWithContext(ctx, withQuery(vp.Endpoint(), opts))
+	respData, err := SendResp(resp, err, vp)
+
+	var results []VendorPayment
+	if err != nil {
+		return results, err
+	}
+	for _, pmt := range respData.VendorPayments {
+		results = append(results, pmt)
+	}
+	return results, err
+}
+
+// Iterator returns an iterator over all the pages of vendor payments with given options
+func (vp *VendorPayment) Iterator(opts *VendorPaymentFindOptions, client *Client) *Iterator[VendorPayment] {
+	return NewIterator(func(ctx context.Context, page, perPage int) ([]VendorPayment, *PageContext, error) {
+		resp, err := client.GetWithContext(ctx, withPage(vp.Endpoint(), opts, page, perPage))
+		respData, err := SendResp(resp, err, vp)
+		if err != nil {
+			return nil, nil, err
+		}
+		return respData.VendorPayments, &respData.PageContext, nil
+	})
+}
+
+// Update method will try to update a vendor payment and its allocation to bills
+func (vp *VendorPayment) Update(id string, params *VendorPaymentParams, client *Client) (*VendorPayment, error) {
+	return vp.UpdateWithContext(context.Background(), id, params, client)
+}
+
+// UpdateWithContext will try to update a vendor payment using the given context
+func (vp *VendorPayment) UpdateWithContext(ctx context.Context, id string, params *VendorPaymentParams, client *Client) (*VendorPayment, error) {
+	var body, _ = json.Marshal(params)
+	resp, err := client.PutWithContext(ctx, vp.Endpoint()+"/"+id, string(body))
+
+	respData, err := SendResp(resp, err, vp)
+	if err != nil {
+		return vp, err
+	}
+	return &respData.VendorPayment, err
+}
+
+// Delete tries to delete the vendor payment with given id
+func (vp *VendorPayment) Delete(id string, client *Client) error {
+	return vp.DeleteWithContext(context.Background(), id, client)
+}
+
+// DeleteWithContext tries to delete the vendor payment with given id using the given context
+func (vp *VendorPayment) DeleteWithContext(ctx context.Context, id string, client *Client) error {
+	resp, err := client.DeleteWithContext(ctx, vp.Endpoint()+"/"+id)
+	_, err = SendResp(resp, err, vp)
+	return err
+}